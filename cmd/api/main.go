@@ -5,11 +5,15 @@ import (
 	"backend-dashboard/internal/delivery/http"
 	"backend-dashboard/internal/delivery/http/middleware"
 	"backend-dashboard/internal/repository"
+	"backend-dashboard/internal/scheduler"
 	"backend-dashboard/internal/usecase"
 	"backend-dashboard/pkg/database"
+	"backend-dashboard/pkg/storage"
+	"context"
 	"fmt"
 	"log"
 	"time"
+	_ "time/tzdata" // timezone data for minimal container images
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/time/rate"
@@ -19,6 +23,13 @@ func main() {
 	// 1. Load Config
 	cfg := config.LoadConfig()
 
+	// Attendance days and deadlines follow the office timezone, not the host's
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		log.Fatalf("Invalid APP_TIMEZONE %q: %v", cfg.Timezone, err)
+	}
+	time.Local = location
+
 	// 2. Connect to Database
 	db, err := database.Connect(cfg)
 	if err != nil {
@@ -29,18 +40,85 @@ func main() {
 	database.AutoMigrate(db)
 	database.SeedRoles(db)
 	database.SeedSuperAdmin(db)
+	database.SeedDefaultRubric(db)
 	database.SeedSampleData(db)
 
 	// 4. Init Layers
+	fileStorage, err := storage.New(cfg)
+	if err != nil {
+		log.Fatalf("Could not initialize file storage: %v", err)
+	}
+	maxUploadSize := int64(cfg.MaxUploadSizeMB) << 20
+
 	userRepo := repository.NewPostgresUserRepository(db)
 	userUsecase := usecase.NewUserUsecase(userRepo, cfg.JWTSecret)
 
 	internRepo := repository.NewInternRepository(db)
 	internUsecase := usecase.NewInternUsecase(internRepo, userRepo)
 
-	// 5. Setup Router
+	taskRepo := repository.NewTaskRepository(db)
+	taskUsecase := usecase.NewTaskUsecase(taskRepo, internRepo, fileStorage)
+
+	taskCommentRepo := repository.NewTaskCommentRepository(db)
+	taskCommentUsecase := usecase.NewTaskCommentUsecase(taskCommentRepo, taskRepo, internRepo, userRepo)
+
+	taskTemplateRepo := repository.NewTaskTemplateRepository(db)
+	taskTemplateUsecase := usecase.NewTaskTemplateUsecase(taskTemplateRepo, taskRepo, internRepo)
+
+	taskRecurrenceRepo := repository.NewTaskRecurrenceRepository(db)
+	taskRecurrenceUsecase := usecase.NewTaskRecurrenceUsecase(taskRecurrenceRepo, taskTemplateRepo, taskRepo, internRepo)
+
+	taskAttachmentRepo := repository.NewTaskAttachmentRepository(db)
+	taskAttachmentUsecase := usecase.NewTaskAttachmentUsecase(taskAttachmentRepo, taskRepo, internRepo, fileStorage, maxUploadSize, cfg.JWTSecret)
+
+	timeEntryRepo := repository.NewTimeEntryRepository(db)
+	timeEntryUsecase := usecase.NewTimeEntryUsecase(timeEntryRepo, taskRepo, internRepo)
+
+	workScheduleRepo := repository.NewWorkScheduleRepository(db)
+	workScheduleUsecase := usecase.NewWorkScheduleUsecase(workScheduleRepo, internRepo)
+
+	holidayRepo := repository.NewHolidayRepository(db)
+	holidayUsecase := usecase.NewHolidayUsecase(holidayRepo, workScheduleRepo, internRepo)
+
+	officeLocationRepo := repository.NewOfficeLocationRepository(db)
+	officeLocationUsecase := usecase.NewOfficeLocationUsecase(officeLocationRepo)
+
+	leaveRequestRepo := repository.NewLeaveRequestRepository(db)
+	leaveRequestUsecase := usecase.NewLeaveRequestUsecase(leaveRequestRepo, workScheduleRepo, holidayRepo, internRepo, fileStorage, maxUploadSize, cfg.MaxLeaveDays)
+
+	attendanceRepo := repository.NewAttendanceRepository(db)
+	attendanceUsecase := usecase.NewAttendanceUsecase(attendanceRepo, workScheduleRepo, holidayRepo, internRepo, leaveRequestRepo, officeLocationRepo, cfg.JWTSecret)
+
+	attendanceCorrectionRepo := repository.NewAttendanceCorrectionRepository(db)
+	attendanceCorrectionUsecase := usecase.NewAttendanceCorrectionUsecase(attendanceCorrectionRepo, attendanceRepo, workScheduleRepo, holidayRepo, leaveRequestRepo, internRepo)
+
+	reviewRubricRepo := repository.NewReviewRubricRepository(db)
+	reviewCycleRepo := repository.NewReviewCycleRepository(db)
+	mentorReviewRepo := repository.NewMentorReviewRepository(db)
+	selfAssessmentRepo := repository.NewSelfAssessmentRepository(db)
+
+	reviewRubricUsecase := usecase.NewReviewRubricUsecase(reviewRubricRepo, mentorReviewRepo, selfAssessmentRepo, internRepo)
+	reviewCycleUsecase := usecase.NewReviewCycleUsecase(reviewCycleRepo, internRepo)
+	mentorReviewUsecase := usecase.NewMentorReviewUsecase(mentorReviewRepo, selfAssessmentRepo, reviewRubricRepo, reviewCycleRepo, internRepo)
+	selfAssessmentUsecase := usecase.NewSelfAssessmentUsecase(selfAssessmentRepo, mentorReviewRepo, reviewRubricRepo, reviewCycleRepo, internRepo)
+
+	peerFeedbackRepo := repository.NewPeerFeedbackRepository(db)
+	peerFeedbackUsecase := usecase.NewPeerFeedbackUsecase(peerFeedbackRepo, reviewRubricRepo, internRepo)
+
+	// 5. Background jobs
+	scheduler.Start(context.Background(),
+		scheduler.Job{Name: "recurring-tasks", Interval: time.Hour, Run: taskRecurrenceUsecase.GenerateOccurrences},
+		scheduler.Job{Name: "alpha-attendance", Interval: time.Hour, Run: attendanceUsecase.MarkAlpha},
+	)
+
+	// 6. Setup Router
 	r := gin.Default()
 
+	// Client IPs decide office check-ins, so only trust forwarding headers from known proxies
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+
 	// CORS Middleware (Simple version for development)
 	r.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
@@ -62,18 +140,37 @@ func main() {
 
 	// Middlewares
 	authMiddleware := middleware.AuthMiddleware(cfg.JWTSecret)
-	superAdminOnly := middleware.RoleMiddleware(1) // role_id 1 = super_admin
-	hrOrAbove := middleware.RoleMiddleware(1, 2)   // role_id 1,2 = super_admin, hr
-	// picOrAbove := middleware.RoleMiddleware(1, 2, 3)   // role_id 1,2,3 = super_admin, hr, pic (for future use)
+	superAdminOnly := middleware.RoleMiddleware(1)   // role_id 1 = super_admin
+	hrOrAbove := middleware.RoleMiddleware(1, 2)     // role_id 1,2 = super_admin, hr
+	picOrAbove := middleware.RoleMiddleware(1, 2, 3) // role_id 1,2,3 = super_admin, hr, pic
 
 	// Handlers
 	userHandler := http.NewUserHandler(r, userUsecase)
 	internHandler := http.NewInternHandler(internUsecase)
 	profileHandler := http.NewProfileHandler(userUsecase)
+	taskHandler := http.NewTaskHandler(taskUsecase)
+	taskCommentHandler := http.NewTaskCommentHandler(taskCommentUsecase)
+	taskAttachmentHandler := http.NewTaskAttachmentHandler(taskAttachmentUsecase, maxUploadSize)
+	taskTemplateHandler := http.NewTaskTemplateHandler(taskTemplateUsecase, taskRecurrenceUsecase)
+	timeEntryHandler := http.NewTimeEntryHandler(timeEntryUsecase)
+	attendanceHandler := http.NewAttendanceHandler(attendanceUsecase)
+	workScheduleHandler := http.NewWorkScheduleHandler(workScheduleUsecase)
+	officeLocationHandler := http.NewOfficeLocationHandler(officeLocationUsecase)
+	leaveRequestHandler := http.NewLeaveRequestHandler(leaveRequestUsecase, maxUploadSize)
+	attendanceCorrectionHandler := http.NewAttendanceCorrectionHandler(attendanceCorrectionUsecase)
+	reviewRubricHandler := http.NewReviewRubricHandler(reviewRubricUsecase)
+	reviewCycleHandler := http.NewReviewCycleHandler(reviewCycleUsecase)
+	mentorReviewHandler := http.NewMentorReviewHandler(mentorReviewUsecase)
+	selfAssessmentHandler := http.NewSelfAssessmentHandler(selfAssessmentUsecase)
+	peerFeedbackHandler := http.NewPeerFeedbackHandler(peerFeedbackUsecase)
+	holidayHandler := http.NewHolidayHandler(holidayUsecase, maxUploadSize)
 
 	// Public routes
 	r.POST("/login", loginRateLimiter, userHandler.Login)
 
+	// Signed download links (authorized by the token in the query string)
+	r.GET("/files/attachments/:attachmentId", taskAttachmentHandler.DownloadAttachment)
+
 	// Protected API routes
 	api := r.Group("/api")
 	api.Use(authMiddleware) // All API routes require authentication
@@ -100,6 +197,200 @@ func main() {
 			interns.GET("/:id", internHandler.GetIntern)
 		}
 
+		// Task management (PIC or above assigns, visibility is scoped per role in the usecase)
+		tasks := api.Group("/tasks")
+		{
+			tasks.POST("", picOrAbove, taskHandler.CreateTask)
+			tasks.GET("", taskHandler.GetTasks)
+			tasks.GET("/overdue", picOrAbove, taskHandler.GetOverdueTasks)
+			tasks.GET("/:id", taskHandler.GetTask)
+			tasks.PUT("/:id", picOrAbove, taskHandler.UpdateTask)
+			tasks.DELETE("/:id", picOrAbove, taskHandler.DeleteTask)
+			tasks.PUT("/:id/status", taskHandler.ChangeTaskStatus)
+			tasks.GET("/:id/history", taskHandler.GetTaskHistory)
+			tasks.PUT("/:id/grade", taskHandler.GradeTask)
+			tasks.GET("/:id/grades", taskHandler.GetTaskGrades)
+
+			// Subtasks and "blocked by" links
+			tasks.GET("/:id/subtasks", taskHandler.GetSubtasks)
+			tasks.POST("/:id/subtasks", picOrAbove, taskHandler.CreateSubtask)
+			tasks.GET("/:id/dependencies", taskHandler.GetDependencies)
+			tasks.POST("/:id/dependencies", picOrAbove, taskHandler.AddDependency)
+			tasks.DELETE("/:id/dependencies/:blockerId", picOrAbove, taskHandler.RemoveDependency)
+
+			// Discussion thread (visible to the intern, their PIC and HR)
+			tasks.GET("/:id/comments", taskCommentHandler.GetComments)
+			tasks.POST("/:id/comments", taskCommentHandler.CreateComment)
+			tasks.PUT("/:id/comments/:commentId", taskCommentHandler.UpdateComment)
+			tasks.DELETE("/:id/comments/:commentId", taskCommentHandler.DeleteComment)
+
+			// Deliverables
+			tasks.GET("/:id/attachments", taskAttachmentHandler.GetAttachments)
+			tasks.POST("/:id/attachments", taskAttachmentHandler.UploadAttachment)
+			tasks.GET("/:id/attachments/:attachmentId/link", taskAttachmentHandler.GetDownloadLink)
+			tasks.DELETE("/:id/attachments/:attachmentId", taskAttachmentHandler.DeleteAttachment)
+
+			// Time logging
+			tasks.GET("/:id/time-entries", timeEntryHandler.GetTaskEntries)
+			tasks.POST("/:id/time-entries", timeEntryHandler.LogTime)
+			tasks.POST("/:id/timer/start", timeEntryHandler.StartTimer)
+		}
+
+		timeEntries := api.Group("/time-entries")
+		{
+			timeEntries.POST("/stop", timeEntryHandler.StopTimer)
+			timeEntries.DELETE("/:id", timeEntryHandler.DeleteEntry)
+			timeEntries.PUT("/:id/review", picOrAbove, timeEntryHandler.ReviewEntry)
+		}
+
+		// Weekly timesheets (interns see their own, PICs review and approve)
+		api.GET("/timesheets", timeEntryHandler.GetTimesheet)
+		api.PUT("/timesheets/approve", picOrAbove, timeEntryHandler.ApproveTimesheet)
+
+		// Attendance (interns check in and out, listings are scoped per role in the usecase)
+		attendance := api.Group("/attendance")
+		{
+			attendance.POST("/check-in", attendanceHandler.CheckIn)
+			attendance.POST("/check-out", attendanceHandler.CheckOut)
+			attendance.GET("/today", attendanceHandler.GetToday)
+			attendance.GET("/schedule", workScheduleHandler.GetMySchedule)
+			attendance.GET("/rate", attendanceHandler.GetAttendanceRate)
+			attendance.GET("/calendar", attendanceHandler.GetCalendar)
+			attendance.GET("/recap", picOrAbove, attendanceHandler.GetMonthlyRecap)
+
+			// Office kiosk showing the rotating check-in QR code
+			attendance.GET("/kiosk/token", hrOrAbove, attendanceHandler.GetKioskToken)
+			attendance.GET("/kiosk/qr.png", hrOrAbove, attendanceHandler.GetKioskQR)
+			attendance.GET("", attendanceHandler.GetAttendances)
+		}
+
+		// Leave (izin) requests: interns submit, their PIC or HR reviews
+		leaveRequests := api.Group("/leave-requests")
+		{
+			leaveRequests.POST("", leaveRequestHandler.SubmitLeave)
+			leaveRequests.GET("", leaveRequestHandler.GetLeaveRequests)
+			leaveRequests.GET("/balance", leaveRequestHandler.GetLeaveBalance)
+			leaveRequests.GET("/:id", leaveRequestHandler.GetLeaveRequest)
+			leaveRequests.GET("/:id/document", leaveRequestHandler.DownloadDocument)
+			leaveRequests.PUT("/:id/approve", picOrAbove, leaveRequestHandler.ApproveLeave)
+			leaveRequests.PUT("/:id/reject", picOrAbove, leaveRequestHandler.RejectLeave)
+			leaveRequests.DELETE("/:id", leaveRequestHandler.CancelLeave)
+		}
+
+		// Attendance Correction routes (fixing past days, approved by PIC or HR)
+		attendanceCorrections := api.Group("/attendance-corrections")
+		{
+			attendanceCorrections.POST("", attendanceCorrectionHandler.RequestCorrection)
+			attendanceCorrections.GET("", attendanceCorrectionHandler.GetCorrections)
+			attendanceCorrections.GET("/:id", attendanceCorrectionHandler.GetCorrection)
+			attendanceCorrections.PUT("/:id/approve", picOrAbove, attendanceCorrectionHandler.ApproveCorrection)
+			attendanceCorrections.PUT("/:id/reject", picOrAbove, attendanceCorrectionHandler.RejectCorrection)
+			attendanceCorrections.DELETE("/:id", attendanceCorrectionHandler.CancelCorrection)
+		}
+
+		// Review rubric routes (HR publishes versions; PICs look up the rubric for a review)
+		reviewRubrics := api.Group("/review-rubrics")
+		{
+			reviewRubrics.GET("/resolve", picOrAbove, reviewRubricHandler.ResolveRubric)
+			reviewRubrics.GET("", hrOrAbove, reviewRubricHandler.GetRubrics)
+			reviewRubrics.GET("/:id", picOrAbove, reviewRubricHandler.GetRubric)
+			reviewRubrics.POST("", hrOrAbove, reviewRubricHandler.CreateRubric)
+			reviewRubrics.DELETE("/:id", hrOrAbove, reviewRubricHandler.DeleteRubric)
+		}
+
+		// Review cycle routes (HR opens, closes and reopens the review window of a period)
+		reviewCycles := api.Group("/review-cycles")
+		{
+			reviewCycles.GET("", picOrAbove, reviewCycleHandler.GetCycles)
+			reviewCycles.GET("/:id", picOrAbove, reviewCycleHandler.GetCycle)
+			reviewCycles.POST("", hrOrAbove, reviewCycleHandler.OpenCycle)
+			reviewCycles.PUT("/:id", hrOrAbove, reviewCycleHandler.UpdateDeadline)
+			reviewCycles.PUT("/:id/close", hrOrAbove, reviewCycleHandler.CloseCycle)
+			reviewCycles.POST("/:id/reopenings", hrOrAbove, reviewCycleHandler.ReopenForIntern)
+		}
+
+		// Mentor Review routes (PICs review their own interns; interns read submitted reviews)
+		mentorReviews := api.Group("/mentor-reviews")
+		{
+			mentorReviews.GET("", mentorReviewHandler.GetReviews)
+			mentorReviews.GET("/:id", mentorReviewHandler.GetReview)
+			mentorReviews.POST("", picOrAbove, mentorReviewHandler.CreateReview)
+			mentorReviews.PUT("/:id", picOrAbove, mentorReviewHandler.UpdateReview)
+			mentorReviews.PUT("/:id/submit", picOrAbove, mentorReviewHandler.SubmitReview)
+			mentorReviews.GET("/:id/comparison", selfAssessmentHandler.CompareWithReview)
+		}
+
+		// Self-assessment routes (interns rate themselves; PICs see them after submitting their review)
+		selfAssessments := api.Group("/self-assessments")
+		{
+			selfAssessments.GET("", selfAssessmentHandler.GetAssessments)
+			selfAssessments.GET("/:id", selfAssessmentHandler.GetAssessment)
+			selfAssessments.POST("", selfAssessmentHandler.CreateAssessment)
+			selfAssessments.PUT("/:id", selfAssessmentHandler.UpdateAssessment)
+			selfAssessments.PUT("/:id/submit", selfAssessmentHandler.SubmitAssessment)
+		}
+
+		// Peer feedback routes (HR runs rounds; interns give anonymous feedback to assigned peers)
+		peerFeedback := api.Group("/peer-feedback")
+		{
+			peerFeedback.GET("/rounds", picOrAbove, peerFeedbackHandler.GetRounds)
+			peerFeedback.GET("/rounds/:id", picOrAbove, peerFeedbackHandler.GetRound)
+			peerFeedback.POST("/rounds", hrOrAbove, peerFeedbackHandler.CreateRound)
+			peerFeedback.PUT("/rounds/:id/close", hrOrAbove, peerFeedbackHandler.CloseRound)
+			peerFeedback.GET("/rounds/:id/results", peerFeedbackHandler.GetResults)
+			peerFeedback.GET("/assignments", peerFeedbackHandler.GetAssignments)
+			peerFeedback.POST("/assignments/:id/feedback", peerFeedbackHandler.SubmitFeedback)
+		}
+
+		// Company calendar: holidays and cuti bersama (HR or above manages them)
+		holidays := api.Group("/holidays")
+		{
+			holidays.GET("", holidayHandler.GetHolidays)
+			holidays.POST("", hrOrAbove, holidayHandler.CreateHoliday)
+			holidays.POST("/import", hrOrAbove, holidayHandler.ImportICal)
+			holidays.PUT("/:id", hrOrAbove, holidayHandler.UpdateHoliday)
+			holidays.DELETE("/:id", hrOrAbove, holidayHandler.DeleteHoliday)
+		}
+		api.GET("/calendar/suggest-deadline", holidayHandler.SuggestDeadline)
+
+		// Work schedules per division and/or batch (HR or above manages them)
+		workSchedules := api.Group("/work-schedules")
+		{
+			workSchedules.GET("", workScheduleHandler.GetSchedules)
+			workSchedules.GET("/:id", workScheduleHandler.GetSchedule)
+			workSchedules.POST("", hrOrAbove, workScheduleHandler.CreateSchedule)
+			workSchedules.PUT("/:id", hrOrAbove, workScheduleHandler.UpdateSchedule)
+			workSchedules.DELETE("/:id", hrOrAbove, workScheduleHandler.DeleteSchedule)
+		}
+
+		// Office Location routes (networks and geofences for check-in)
+		officeLocations := api.Group("/office-locations")
+		officeLocations.Use(hrOrAbove)
+		{
+			officeLocations.GET("", officeLocationHandler.GetLocations)
+			officeLocations.GET("/:id", officeLocationHandler.GetLocation)
+			officeLocations.POST("", officeLocationHandler.CreateLocation)
+			officeLocations.PUT("/:id", officeLocationHandler.UpdateLocation)
+			officeLocations.DELETE("/:id", officeLocationHandler.DeleteLocation)
+		}
+
+		api.GET("/mentions", taskCommentHandler.GetMentions)
+
+		// Task templates and bulk assignment (PIC or above)
+		taskTemplates := api.Group("/task-templates")
+		taskTemplates.Use(picOrAbove)
+		{
+			taskTemplates.GET("", taskTemplateHandler.GetTemplates)
+			taskTemplates.POST("", taskTemplateHandler.CreateTemplate)
+			taskTemplates.GET("/:id", taskTemplateHandler.GetTemplate)
+			taskTemplates.PUT("/:id", taskTemplateHandler.UpdateTemplate)
+			taskTemplates.DELETE("/:id", taskTemplateHandler.DeleteTemplate)
+			taskTemplates.POST("/:id/assign", taskTemplateHandler.AssignTemplate)
+			taskTemplates.GET("/:id/recurrences", taskTemplateHandler.GetRecurrences)
+			taskTemplates.POST("/:id/recurrences", taskTemplateHandler.CreateRecurrence)
+			taskTemplates.DELETE("/:id/recurrences/:recurrenceId", taskTemplateHandler.DeactivateRecurrence)
+		}
+
 		// Profile management (all authenticated users)
 		profile := api.Group("/profile")
 		{
@@ -110,7 +401,7 @@ func main() {
 		}
 	}
 
-	// 6. Run Server
+	// 7. Run Server
 	serverAddr := fmt.Sprintf(":%s", cfg.AppPort)
 	log.Printf("Server starting on port %s", cfg.AppPort)
 	if err := r.Run(serverAddr); err != nil {
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"backend-dashboard/internal/config"
+	"backend-dashboard/internal/repository"
+	"backend-dashboard/internal/usecase"
+	"backend-dashboard/pkg/database"
+	"flag"
+	"log"
+	"time"
+	_ "time/tzdata"
+)
+
+// mark-alpha backfills alpha attendance for a past date range, e.g.
+//
+//	go run ./cmd/mark-alpha -from 2026-01-05 -to 2026-01-09
+//
+// Days that already have a record are left untouched, so it is safe to re-run.
+func main() {
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	fromFlag := flag.String("from", yesterday, "first day to process (YYYY-MM-DD)")
+	toFlag := flag.String("to", "", "last day to process (YYYY-MM-DD), defaults to -from")
+	flag.Parse()
+
+	cfg := config.LoadConfig()
+
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		log.Fatalf("Invalid APP_TIMEZONE %q: %v", cfg.Timezone, err)
+	}
+	time.Local = location
+
+	from, err := time.ParseInLocation("2006-01-02", *fromFlag, time.Local)
+	if err != nil {
+		log.Fatalf("Invalid -from date %q: use YYYY-MM-DD", *fromFlag)
+	}
+	to := from
+	if *toFlag != "" {
+		to, err = time.ParseInLocation("2006-01-02", *toFlag, time.Local)
+		if err != nil {
+			log.Fatalf("Invalid -to date %q: use YYYY-MM-DD", *toFlag)
+		}
+	}
+	if to.Before(from) {
+		log.Fatalf("-to must not be before -from")
+	}
+
+	db, err := database.Connect(cfg)
+	if err != nil {
+		log.Fatalf("Could not connect to database: %v", err)
+	}
+
+	internRepo := repository.NewInternRepository(db)
+	workScheduleRepo := repository.NewWorkScheduleRepository(db)
+	holidayRepo := repository.NewHolidayRepository(db)
+	leaveRequestRepo := repository.NewLeaveRequestRepository(db)
+	officeLocationRepo := repository.NewOfficeLocationRepository(db)
+	attendanceRepo := repository.NewAttendanceRepository(db)
+	attendanceUsecase := usecase.NewAttendanceUsecase(attendanceRepo, workScheduleRepo, holidayRepo, internRepo, leaveRequestRepo, officeLocationRepo, cfg.JWTSecret)
+
+	created, err := attendanceUsecase.MarkAlphaRange(from, to, time.Now())
+	if err != nil {
+		log.Fatalf("Failed to mark alpha: %v", err)
+	}
+
+	log.Printf("Marked %d intern(s) alpha between %s and %s", created, from.Format("2006-01-02"), to.Format("2006-01-02"))
+}
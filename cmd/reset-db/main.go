@@ -30,9 +30,35 @@ func main() {
 		&domain.NineGridResult{},
 		&domain.PotentialScore{},
 		&domain.PerformanceScore{},
+		&domain.PeerFeedbackAnswer{},
+		&domain.PeerFeedback{},
+		&domain.PeerFeedbackAssignment{},
+		&domain.PeerFeedbackRound{},
+		&domain.SelfAssessmentAnswer{},
+		&domain.SelfAssessment{},
+		&domain.ReviewAnswer{},
 		&domain.MentorReview{},
+		&domain.ReviewReopening{},
+		&domain.ReviewCycle{},
+		&domain.RubricCriterion{},
+		&domain.ReviewRubric{},
+		&domain.AttendanceCorrection{},
+		&domain.LeaveRequest{},
 		&domain.Attendance{},
+		&domain.OfficeLocation{},
+		&domain.WorkSchedule{},
+		&domain.Holiday{},
+		&domain.TimeEntry{},
+		&domain.TaskAttachment{},
+		&domain.TaskCommentMention{},
+		&domain.TaskComment{},
+		&domain.TaskGrade{},
+		&domain.TaskStatusHistory{},
+		&domain.TaskDependency{},
 		&domain.Task{},
+		&domain.SkippedOccurrence{},
+		&domain.TaskRecurrence{},
+		&domain.TaskTemplate{},
 		&domain.HRProfile{},
 		&domain.PICProfile{},
 		&domain.InternProfile{},
@@ -46,6 +72,7 @@ func main() {
 	database.AutoMigrate(db)
 	database.SeedRoles(db)
 	database.SeedSuperAdmin(db)
+	database.SeedDefaultRubric(db)
 	database.SeedSampleData(db)
 
 	log.Println("Migration and seeding completed!")
@@ -3,6 +3,8 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -15,6 +17,21 @@ type Config struct {
 	DBName     string
 	DBPort     string
 	JWTSecret  string
+
+	// Reverse proxies allowed to set X-Forwarded-For. Empty trusts none,
+	// so the client IP recorded at check-in is the connecting address.
+	TrustedProxies []string
+
+	// Timezone used to decide which calendar day a check-in belongs to
+	Timezone string
+
+	// File storage for uploads (task attachments, etc.)
+	StorageDriver   string
+	StorageDir      string
+	MaxUploadSizeMB int
+
+	// Leave (izin) days an intern may take over the whole internship
+	MaxLeaveDays int
 }
 
 func LoadConfig() *Config {
@@ -31,6 +48,16 @@ func LoadConfig() *Config {
 		DBName:     getEnv("DB_NAME", "dashtern"),
 		DBPort:     getEnv("DB_PORT", "5432"),
 		JWTSecret:  getEnv("JWT_SECRET", "secret"),
+
+		TrustedProxies: getEnvList("TRUSTED_PROXIES"),
+
+		Timezone: getEnv("APP_TIMEZONE", "Asia/Jakarta"),
+
+		StorageDriver:   getEnv("STORAGE_DRIVER", "local"),
+		StorageDir:      getEnv("STORAGE_DIR", "./uploads"),
+		MaxUploadSizeMB: getEnvInt("MAX_UPLOAD_SIZE_MB", 10),
+
+		MaxLeaveDays: getEnvInt("MAX_LEAVE_DAYS", 12),
 	}
 }
 
@@ -40,3 +67,26 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, ""), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Note: %s=%q is not a number, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,232 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AttendanceCorrectionHandler handles attendance correction HTTP requests
+type AttendanceCorrectionHandler struct {
+	AttendanceCorrectionUsecase domain.AttendanceCorrectionUsecase
+}
+
+// NewAttendanceCorrectionHandler creates a new attendance correction handler
+func NewAttendanceCorrectionHandler(attendanceCorrectionUsecase domain.AttendanceCorrectionUsecase) *AttendanceCorrectionHandler {
+	return &AttendanceCorrectionHandler{
+		AttendanceCorrectionUsecase: attendanceCorrectionUsecase,
+	}
+}
+
+// RequestCorrection handles POST /api/attendance-corrections
+// Times are HH:MM on the corrected date and are left out for izin
+func (h *AttendanceCorrectionHandler) RequestCorrection(c *gin.Context) {
+	var req struct {
+		Date         string `json:"date" binding:"required"`
+		Status       string `json:"status" binding:"required"`
+		CheckInTime  string `json:"check_in_time"`
+		CheckOutTime string `json:"check_out_time"`
+		Reason       string `json:"reason" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+	var checkIn *time.Time
+	if req.CheckInTime != "" {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", req.Date+" "+req.CheckInTime, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid check_in_time format. Use HH:MM"})
+			return
+		}
+		checkIn = &parsed
+	}
+
+	var checkOut *time.Time
+	if req.CheckOutTime != "" {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", req.Date+" "+req.CheckOutTime, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid check_out_time format. Use HH:MM"})
+			return
+		}
+		checkOut = &parsed
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	correction, err := h.AttendanceCorrectionUsecase.RequestCorrection(actorID, actorRoleID, date, req.Status, checkIn, checkOut, req.Reason)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Correction requested successfully",
+		"data":    correction,
+	})
+}
+
+// GetCorrections handles GET /api/attendance-corrections
+// Supports intern_id and status filters
+func (h *AttendanceCorrectionHandler) GetCorrections(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter := domain.AttendanceCorrectionFilter{Status: c.Query("status")}
+	if v := c.Query("intern_id"); v != "" {
+		internID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		filter.InternID = uint(internID)
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	corrections, total, err := h.AttendanceCorrectionUsecase.GetCorrections(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        corrections,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetCorrection handles GET /api/attendance-corrections/:id
+func (h *AttendanceCorrectionHandler) GetCorrection(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid correction ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	correction, err := h.AttendanceCorrectionUsecase.GetCorrectionByID(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": correction,
+	})
+}
+
+// ApproveCorrection handles PUT /api/attendance-corrections/:id/approve
+func (h *AttendanceCorrectionHandler) ApproveCorrection(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid correction ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	correction, err := h.AttendanceCorrectionUsecase.ApproveCorrection(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Correction approved",
+		"data":    correction,
+	})
+}
+
+// RejectCorrection handles PUT /api/attendance-corrections/:id/reject
+func (h *AttendanceCorrectionHandler) RejectCorrection(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid correction ID"})
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	correction, err := h.AttendanceCorrectionUsecase.RejectCorrection(actorID, actorRoleID, uint(id), req.Reason)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Correction rejected",
+		"data":    correction,
+	})
+}
+
+// CancelCorrection handles DELETE /api/attendance-corrections/:id
+func (h *AttendanceCorrectionHandler) CancelCorrection(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid correction ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.AttendanceCorrectionUsecase.CancelCorrection(actorID, actorRoleID, uint(id)); err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Correction cancelled successfully",
+	})
+}
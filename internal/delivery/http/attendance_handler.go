@@ -0,0 +1,466 @@
+package http
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// kioskQRSize is the width and height of the kiosk QR code in pixels
+const kioskQRSize = 512
+
+// AttendanceHandler handles attendance HTTP requests
+type AttendanceHandler struct {
+	AttendanceUsecase domain.AttendanceUsecase
+}
+
+// NewAttendanceHandler creates a new attendance handler
+func NewAttendanceHandler(attendanceUsecase domain.AttendanceUsecase) *AttendanceHandler {
+	return &AttendanceHandler{
+		AttendanceUsecase: attendanceUsecase,
+	}
+}
+
+// CheckIn handles POST /api/attendance/check-in
+// The token is the value of the QR code shown on the office kiosk. Interns of divisions
+// with hybrid office locations may instead check in from such a network or send their coordinates.
+func (h *AttendanceHandler) CheckIn(c *gin.Context) {
+	var req struct {
+		Token     string   `json:"token"`
+		Latitude  *float64 `json:"latitude" binding:"omitempty,min=-90,max=90"`
+		Longitude *float64 `json:"longitude" binding:"omitempty,min=-180,max=180"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	attendance, err := h.AttendanceUsecase.CheckIn(actorID, actorRoleID, domain.CheckInInput{
+		KioskToken: req.Token,
+		ClientIP:   c.ClientIP(),
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+	})
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Checked in successfully",
+		"data":    attendance,
+	})
+}
+
+// GetKioskToken handles GET /api/attendance/kiosk/token
+// Returns the current check-in token for kiosks that render the QR code themselves
+func (h *AttendanceHandler) GetKioskToken(c *gin.Context) {
+	token, refreshAt, err := h.AttendanceUsecase.CreateKioskToken(time.Now())
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"token":      token,
+		"refresh_at": refreshAt,
+	})
+}
+
+// GetKioskQR handles GET /api/attendance/kiosk/qr.png
+// The kiosk should reload the image at the time given in the X-Refresh-At header
+func (h *AttendanceHandler) GetKioskQR(c *gin.Context) {
+	token, refreshAt, err := h.AttendanceUsecase.CreateKioskToken(time.Now())
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	png, err := qrcode.Encode(token, qrcode.Medium, kioskQRSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render QR code"})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Refresh-At", refreshAt.Format(time.RFC3339))
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// CheckOut handles POST /api/attendance/check-out
+func (h *AttendanceHandler) CheckOut(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	attendance, err := h.AttendanceUsecase.CheckOut(actorID, actorRoleID)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Checked out successfully",
+		"data":    attendance,
+	})
+}
+
+// GetToday handles GET /api/attendance/today
+func (h *AttendanceHandler) GetToday(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	attendance, err := h.AttendanceUsecase.GetToday(actorID, actorRoleID)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": attendance,
+	})
+}
+
+// GetAttendances handles GET /api/attendance
+// Supports intern_id, status, remote (true/false), date_from and date_to (YYYY-MM-DD) filters
+func (h *AttendanceHandler) GetAttendances(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter, err := parseAttendanceFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	records, total, err := h.AttendanceUsecase.GetAttendances(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        records,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetAttendanceRate handles GET /api/attendance/rate?intern_id=&from=YYYY-MM-DD&to=YYYY-MM-DD
+// Interns may omit intern_id; the period defaults to the current month
+func (h *AttendanceHandler) GetAttendanceRate(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	internID := actorID
+	if v := c.Query("intern_id"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		internID = uint(id)
+	}
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 1, -1)
+
+	if v := c.Query("from"); v != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from"})
+			return
+		}
+		from = parsed
+	}
+	if v := c.Query("to"); v != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to"})
+			return
+		}
+		to = parsed
+	}
+
+	rate, err := h.AttendanceUsecase.GetAttendanceRate(actorID, actorRoleID, internID, from, to)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": rate,
+	})
+}
+
+// GetCalendar handles GET /api/attendance/calendar?intern_id=&period=YYYY-MM
+// Interns may omit intern_id; the period defaults to the current month
+func (h *AttendanceHandler) GetCalendar(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	internID := actorID
+	if v := c.Query("intern_id"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		internID = uint(id)
+	}
+
+	period := c.Query("period")
+	if period == "" {
+		period = time.Now().Format("2006-01")
+	}
+
+	days, err := h.AttendanceUsecase.GetCalendar(actorID, actorRoleID, internID, period)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"intern_id": internID,
+		"period":    period,
+		"data":      days,
+	})
+}
+
+// GetMonthlyRecap handles GET /api/attendance/recap?period=YYYY-MM
+// Supports batch, division and pic_id filters; format=csv downloads the recap as a spreadsheet
+func (h *AttendanceHandler) GetMonthlyRecap(c *gin.Context) {
+	period := c.Query("period")
+	if period == "" {
+		period = time.Now().Format("2006-01")
+	}
+
+	filter := domain.AttendanceRecapFilter{
+		Batch:    c.Query("batch"),
+		Division: c.Query("division"),
+	}
+	if v := c.Query("pic_id"); v != "" {
+		picID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pic_id"})
+			return
+		}
+		filter.PICID = uint(picID)
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	recaps, err := h.AttendanceUsecase.GetMonthlyRecap(actorID, actorRoleID, period, filter)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		writeRecapCSV(c, period, recaps)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"period": period,
+		"data":   recaps,
+	})
+}
+
+// writeRecapCSV streams the monthly recap as a CSV attachment
+func writeRecapCSV(c *gin.Context, period string, recaps []domain.AttendanceRecap) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "attendance-recap-"+period+".csv"))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"intern_id", "full_name", "batch", "division", "period", "working_days", "present", "late", "izin", "alpha", "attendance_rate"})
+	for _, recap := range recaps {
+		w.Write([]string{
+			strconv.FormatUint(uint64(recap.InternID), 10),
+			csvText(recap.FullName),
+			csvText(recap.Batch),
+			csvText(recap.Division),
+			recap.Period,
+			strconv.Itoa(recap.WorkingDays),
+			strconv.Itoa(recap.Present),
+			strconv.Itoa(recap.Late),
+			strconv.Itoa(recap.Izin),
+			strconv.Itoa(recap.Alpha),
+			strconv.FormatFloat(recap.Rate, 'f', 2, 64),
+		})
+	}
+	w.Flush()
+}
+
+// csvText neutralizes user-entered text that spreadsheets would evaluate as a formula,
+// including cells starting with a tab or carriage return
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func parseAttendanceFilter(c *gin.Context) (domain.AttendanceFilter, error) {
+	var filter domain.AttendanceFilter
+
+	if v := c.Query("intern_id"); v != "" {
+		internID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return filter, errInvalidQuery("intern_id")
+		}
+		filter.InternID = uint(internID)
+	}
+
+	filter.Status = c.Query("status")
+
+	if v := c.Query("remote"); v != "" {
+		remote, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errInvalidQuery("remote")
+		}
+		filter.IsRemote = &remote
+	}
+
+	if v := c.Query("date_from"); v != "" {
+		from, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return filter, errInvalidQuery("date_from")
+		}
+		filter.DateFrom = &from
+	}
+
+	if v := c.Query("date_to"); v != "" {
+		to, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return filter, errInvalidQuery("date_to")
+		}
+		filter.DateTo = &to
+	}
+
+	return filter, nil
+}
+
+// respondAttendanceError maps attendance errors to HTTP responses
+func respondAttendanceError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrAttendanceNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Attendance record not found"})
+	case domain.ErrAlreadyCheckedIn:
+		c.JSON(http.StatusConflict, gin.H{"error": "Already checked in today"})
+	case domain.ErrNotCheckedIn:
+		c.JSON(http.StatusConflict, gin.H{"error": "Not checked in today"})
+	case domain.ErrAlreadyCheckedOut:
+		c.JSON(http.StatusConflict, gin.H{"error": "Already checked out today"})
+	case domain.ErrWorkScheduleNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Work schedule not found"})
+	case domain.ErrWorkScheduleExists:
+		c.JSON(http.StatusConflict, gin.H{"error": "A work schedule already exists for this division and batch"})
+	case domain.ErrInvalidWorkSchedule:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid work schedule: use HH:MM times, end after start, and working days 0-6"})
+	case domain.ErrHolidayNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Holiday not found"})
+	case domain.ErrHolidayExists:
+		c.JSON(http.StatusConflict, gin.H{"error": "A holiday already exists on this date"})
+	case domain.ErrInvalidHolidayType:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Type must be public_holiday or cuti_bersama"})
+	case domain.ErrInvalidCalendarFile:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is not a valid iCalendar (.ics) file"})
+	case domain.ErrInvalidWorkingDays:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "working_days must be between 1 and 366"})
+	case domain.ErrLeaveNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Leave request not found"})
+	case domain.ErrLeaveDocumentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Leave request has no document"})
+	case domain.ErrLeaveNotPending:
+		c.JSON(http.StatusConflict, gin.H{"error": "Leave request has already been reviewed"})
+	case domain.ErrLeaveOverlap:
+		c.JSON(http.StatusConflict, gin.H{"error": "Leave request overlaps an existing request"})
+	case domain.ErrLeaveQuotaExceeded:
+		c.JSON(http.StatusConflict, gin.H{"error": "Leave request exceeds the remaining leave allowance"})
+	case domain.ErrInvalidLeavePeriod:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Leave period must fall within the internship and cover at least one working day"})
+	case domain.ErrInvalidLeaveCategory:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Category must be sick, campus or personal"})
+	case domain.ErrReasonRequired:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A reason is required"})
+	case domain.ErrFileTooLarge:
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File exceeds the upload size limit"})
+	case domain.ErrFileTypeNotAllowed:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "File type is not allowed"})
+	case domain.ErrInvalidKioskToken:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Check-in code is invalid or has expired, scan the kiosk again"})
+	case domain.ErrInvalidPeriod:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid period format. Use YYYY-MM"})
+	case domain.ErrOutsideOffice:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Check-in is only allowed from the office network or location"})
+	case domain.ErrCorrectionNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Attendance correction not found"})
+	case domain.ErrCorrectionNotPending:
+		c.JSON(http.StatusConflict, gin.H{"error": "Attendance correction has already been reviewed"})
+	case domain.ErrCorrectionExists:
+		c.JSON(http.StatusConflict, gin.H{"error": "A correction for this date is already pending"})
+	case domain.ErrInvalidCorrection:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Status must be hadir or terlambat with a check-in, or izin without times, and check-out must be after check-in"})
+	case domain.ErrCorrectionOnLeave:
+		c.JSON(http.StatusConflict, gin.H{"error": "The date is covered by approved leave"})
+	case domain.ErrCorrectionMismatch:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Status does not match the check-in time and the work schedule"})
+	case domain.ErrInvalidCorrectionDate:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only past working days can be corrected"})
+	case domain.ErrOfficeLocationNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Office location not found"})
+	case domain.ErrInvalidOfficeLocation:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid office location. Give valid CIDR ranges and/or coordinates with a radius, and enforcement reject or flag"})
+	case domain.ErrOutsideInternshipPeriod:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Today is outside your internship period"})
+	case domain.ErrInternNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Intern not found"})
+	case domain.ErrForbidden:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
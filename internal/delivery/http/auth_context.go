@@ -0,0 +1,31 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentUser returns the user ID and role ID set by AuthMiddleware.
+// It writes a 401 response and returns false when they are missing.
+func currentUser(c *gin.Context) (uint, uint, bool) {
+	userID, ok := c.Get("user_id")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return 0, 0, false
+	}
+	roleID, ok := c.Get("role_id")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User role not found"})
+		return 0, 0, false
+	}
+
+	uid, ok1 := userID.(uint)
+	rid, ok2 := roleID.(uint)
+	if !ok1 || !ok2 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID"})
+		return 0, 0, false
+	}
+
+	return uid, rid, true
+}
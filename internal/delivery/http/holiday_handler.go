@@ -0,0 +1,229 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HolidayHandler handles holiday calendar HTTP requests
+type HolidayHandler struct {
+	HolidayUsecase domain.HolidayUsecase
+	MaxUploadSize  int64
+}
+
+// NewHolidayHandler creates a new holiday handler
+func NewHolidayHandler(holidayUsecase domain.HolidayUsecase, maxUploadSize int64) *HolidayHandler {
+	return &HolidayHandler{
+		HolidayUsecase: holidayUsecase,
+		MaxUploadSize:  maxUploadSize,
+	}
+}
+
+type holidayRequest struct {
+	Date string `json:"date" binding:"required"`
+	Name string `json:"name" binding:"required"`
+	Type string `json:"type" binding:"required,oneof=public_holiday cuti_bersama"`
+}
+
+// CreateHoliday handles POST /api/holidays
+func (h *HolidayHandler) CreateHoliday(c *gin.Context) {
+	var req holidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	holiday, err := h.HolidayUsecase.CreateHoliday(actorID, date, req.Name, req.Type)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Holiday created successfully",
+		"data":    holiday,
+	})
+}
+
+// GetHolidays handles GET /api/holidays?year=YYYY or ?from=YYYY-MM-DD&to=YYYY-MM-DD
+// Defaults to the current year
+func (h *HolidayHandler) GetHolidays(c *gin.Context) {
+	year := time.Now().Year()
+	if v := c.Query("year"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid year"})
+			return
+		}
+		year = parsed
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(year, time.December, 31, 0, 0, 0, 0, time.Local)
+
+	if v := c.Query("from"); v != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from"})
+			return
+		}
+		from = parsed
+	}
+	if v := c.Query("to"); v != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to"})
+			return
+		}
+		to = parsed
+	}
+
+	holidays, err := h.HolidayUsecase.GetHolidays(from, to)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": holidays,
+	})
+}
+
+// UpdateHoliday handles PUT /api/holidays/:id
+func (h *HolidayHandler) UpdateHoliday(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid holiday ID"})
+		return
+	}
+
+	var req holidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+
+	holiday, err := h.HolidayUsecase.UpdateHoliday(uint(id), date, req.Name, req.Type)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Holiday updated successfully",
+		"data":    holiday,
+	})
+}
+
+// DeleteHoliday handles DELETE /api/holidays/:id
+func (h *HolidayHandler) DeleteHoliday(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid holiday ID"})
+		return
+	}
+
+	if err := h.HolidayUsecase.DeleteHoliday(uint(id)); err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Holiday deleted successfully",
+	})
+}
+
+// ImportICal handles POST /api/holidays/import (multipart form field "file", optional "type")
+func (h *HolidayHandler) ImportICal(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.MaxUploadSize+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required or exceeds the size limit"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.HolidayUsecase.ImportICal(actorID, file, c.PostForm("type"))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Calendar imported successfully",
+		"data":    result,
+	})
+}
+
+// SuggestDeadline handles GET /api/calendar/suggest-deadline?working_days=N&from=YYYY-MM-DD&intern_id=
+// Counts working days after from (default today), skipping weekends and holidays
+func (h *HolidayHandler) SuggestDeadline(c *gin.Context) {
+	workingDays, err := strconv.Atoi(c.Query("working_days"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid working_days"})
+		return
+	}
+
+	from := time.Now()
+	if v := c.Query("from"); v != "" {
+		from, err = time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from"})
+			return
+		}
+	}
+
+	var internID uint
+	if v := c.Query("intern_id"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		internID = uint(id)
+	}
+
+	deadline, err := h.HolidayUsecase.SuggestDeadline(internID, from, workingDays)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"deadline": deadline,
+	})
+}
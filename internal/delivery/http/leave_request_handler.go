@@ -0,0 +1,294 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LeaveRequestHandler handles leave (izin) request HTTP requests
+type LeaveRequestHandler struct {
+	LeaveRequestUsecase domain.LeaveRequestUsecase
+	MaxUploadSize       int64
+}
+
+// NewLeaveRequestHandler creates a new leave request handler
+func NewLeaveRequestHandler(leaveRequestUsecase domain.LeaveRequestUsecase, maxUploadSize int64) *LeaveRequestHandler {
+	return &LeaveRequestHandler{
+		LeaveRequestUsecase: leaveRequestUsecase,
+		MaxUploadSize:       maxUploadSize,
+	}
+}
+
+// SubmitLeave handles POST /api/leave-requests
+// Accepts JSON, or multipart form data with an optional "document" file
+func (h *LeaveRequestHandler) SubmitLeave(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.MaxUploadSize+multipartOverhead)
+
+	var req struct {
+		StartDate string `json:"start_date" form:"start_date" binding:"required"`
+		EndDate   string `json:"end_date" form:"end_date" binding:"required"`
+		Category  string `json:"category" form:"category" binding:"required,oneof=sick campus personal"`
+		Reason    string `json:"reason" form:"reason"`
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, err := time.ParseInLocation("2006-01-02", req.StartDate, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid start_date format. Use YYYY-MM-DD"})
+		return
+	}
+	endDate, err := time.ParseInLocation("2006-01-02", req.EndDate, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid end_date format. Use YYYY-MM-DD"})
+		return
+	}
+
+	var document *domain.LeaveDocument
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		if header, err := c.FormFile("document"); err == nil {
+			file, err := header.Open()
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+				return
+			}
+			defer file.Close()
+
+			document = &domain.LeaveDocument{FileName: header.Filename, Size: header.Size, Content: file}
+		} else if err != http.ErrMissingFile {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Document exceeds the size limit"})
+			return
+		}
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	leave, err := h.LeaveRequestUsecase.SubmitLeave(actorID, actorRoleID, startDate, endDate, req.Category, req.Reason, document)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Leave request submitted successfully",
+		"data":    leave,
+	})
+}
+
+// GetLeaveRequests handles GET /api/leave-requests
+// Supports intern_id and status filters
+func (h *LeaveRequestHandler) GetLeaveRequests(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter := domain.LeaveRequestFilter{Status: c.Query("status")}
+	if v := c.Query("intern_id"); v != "" {
+		internID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		filter.InternID = uint(internID)
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	leaves, total, err := h.LeaveRequestUsecase.GetLeaveRequests(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        leaves,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetLeaveRequest handles GET /api/leave-requests/:id
+func (h *LeaveRequestHandler) GetLeaveRequest(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leave request ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	leave, err := h.LeaveRequestUsecase.GetLeaveRequestByID(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": leave,
+	})
+}
+
+// DownloadDocument handles GET /api/leave-requests/:id/document
+func (h *LeaveRequestHandler) DownloadDocument(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leave request ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	leave, file, err := h.LeaveRequestUsecase.OpenDocument(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+	defer file.Close()
+
+	c.DataFromReader(http.StatusOK, leave.DocumentSize, leave.DocumentContentType, file, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", leave.DocumentName),
+	})
+}
+
+// ApproveLeave handles PUT /api/leave-requests/:id/approve
+func (h *LeaveRequestHandler) ApproveLeave(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leave request ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	leave, err := h.LeaveRequestUsecase.ApproveLeave(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Leave request approved",
+		"data":    leave,
+	})
+}
+
+// RejectLeave handles PUT /api/leave-requests/:id/reject
+func (h *LeaveRequestHandler) RejectLeave(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leave request ID"})
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	leave, err := h.LeaveRequestUsecase.RejectLeave(actorID, actorRoleID, uint(id), req.Reason)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Leave request rejected",
+		"data":    leave,
+	})
+}
+
+// CancelLeave handles DELETE /api/leave-requests/:id
+func (h *LeaveRequestHandler) CancelLeave(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leave request ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.LeaveRequestUsecase.CancelLeave(actorID, actorRoleID, uint(id)); err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Leave request cancelled successfully",
+	})
+}
+
+// GetLeaveBalance handles GET /api/leave-requests/balance?intern_id=
+// Interns may omit intern_id to get their own balance
+func (h *LeaveRequestHandler) GetLeaveBalance(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	internID := actorID
+	if v := c.Query("intern_id"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		internID = uint(id)
+	}
+
+	balance, err := h.LeaveRequestUsecase.GetLeaveBalance(actorID, actorRoleID, internID)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": balance,
+	})
+}
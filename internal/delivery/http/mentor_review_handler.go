@@ -0,0 +1,270 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MentorReviewHandler handles mentor review HTTP requests
+type MentorReviewHandler struct {
+	MentorReviewUsecase domain.MentorReviewUsecase
+}
+
+// NewMentorReviewHandler creates a new mentor review handler
+func NewMentorReviewHandler(mentorReviewUsecase domain.MentorReviewUsecase) *MentorReviewHandler {
+	return &MentorReviewHandler{
+		MentorReviewUsecase: mentorReviewUsecase,
+	}
+}
+
+// mentorAnswersRequest is the rubric answers body shared by mentor reviews and self-assessments
+type mentorAnswersRequest struct {
+	Answers []struct {
+		CriterionID uint   `json:"criterion_id" binding:"required"`
+		Score       int    `json:"score" binding:"required"`
+		Comment     string `json:"comment"`
+	} `json:"answers" binding:"required,min=1,dive"`
+	Notes string `json:"notes"`
+}
+
+// answerInputs converts the request answers for the usecase
+func (r *mentorAnswersRequest) answerInputs() []domain.ReviewAnswerInput {
+	answers := make([]domain.ReviewAnswerInput, 0, len(r.Answers))
+	for _, answer := range r.Answers {
+		answers = append(answers, domain.ReviewAnswerInput{
+			CriterionID: answer.CriterionID,
+			Score:       answer.Score,
+			Comment:     answer.Comment,
+		})
+	}
+	return answers
+}
+
+// CreateReview handles POST /api/mentor-reviews
+// Saves a draft; answers must score every criterion of the rubric that applies to the intern and period
+func (h *MentorReviewHandler) CreateReview(c *gin.Context) {
+	var req struct {
+		InternID uint   `json:"intern_id" binding:"required"`
+		Period   string `json:"period" binding:"required"`
+		mentorAnswersRequest
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.MentorReviewUsecase.CreateReview(actorID, actorRoleID, req.InternID, req.Period, req.answerInputs(), req.Notes)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Review draft saved successfully",
+		"data":    review,
+	})
+}
+
+// GetReviews handles GET /api/mentor-reviews
+// Supports intern_id, period and status filters
+func (h *MentorReviewHandler) GetReviews(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter := domain.MentorReviewFilter{
+		Period: c.Query("period"),
+		Status: c.Query("status"),
+	}
+	if v := c.Query("intern_id"); v != "" {
+		internID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		filter.InternID = uint(internID)
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	reviews, total, err := h.MentorReviewUsecase.GetReviews(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        reviews,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetReview handles GET /api/mentor-reviews/:id
+func (h *MentorReviewHandler) GetReview(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.MentorReviewUsecase.GetReviewByID(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": review,
+	})
+}
+
+// UpdateReview handles PUT /api/mentor-reviews/:id
+func (h *MentorReviewHandler) UpdateReview(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	var req mentorAnswersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.MentorReviewUsecase.UpdateReview(actorID, actorRoleID, uint(id), req.answerInputs(), req.Notes)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Review updated successfully",
+		"data":    review,
+	})
+}
+
+// SubmitReview handles PUT /api/mentor-reviews/:id/submit
+func (h *MentorReviewHandler) SubmitReview(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.MentorReviewUsecase.SubmitReview(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Review submitted successfully",
+		"data":    review,
+	})
+}
+
+// respondReviewError maps review errors to HTTP responses
+func respondReviewError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrMentorReviewNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Review not found"})
+	case domain.ErrMentorReviewExists:
+		c.JSON(http.StatusConflict, gin.H{"error": "The intern already has a review for this period"})
+	case domain.ErrMentorReviewSubmitted:
+		c.JSON(http.StatusConflict, gin.H{"error": "Review has been submitted and can no longer be changed"})
+	case domain.ErrLegacyMentorReview:
+		c.JSON(http.StatusConflict, gin.H{"error": "Reviews written before rubrics existed can no longer be changed"})
+	case domain.ErrReviewCycleNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Review cycle not found"})
+	case domain.ErrReviewCycleExists:
+		c.JSON(http.StatusConflict, gin.H{"error": "A review cycle already exists for this period"})
+	case domain.ErrReviewCycleClosed:
+		c.JSON(http.StatusConflict, gin.H{"error": "The review cycle for this period is not open"})
+	case domain.ErrReopeningNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Reopening not found"})
+	case domain.ErrInvalidReviewDeadline:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Deadline must not be in the past"})
+	case domain.ErrSelfAssessmentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Self-assessment not found"})
+	case domain.ErrSelfAssessmentExists:
+		c.JSON(http.StatusConflict, gin.H{"error": "You already have a self-assessment for this period"})
+	case domain.ErrSelfAssessmentSubmitted:
+		c.JSON(http.StatusConflict, gin.H{"error": "Self-assessment has been submitted and can no longer be changed"})
+	case domain.ErrSelfAssessmentHidden:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Submit your review for this period before viewing the self-assessment"})
+	case domain.ErrPeerRoundNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Peer feedback round not found"})
+	case domain.ErrPeerRoundClosed:
+		c.JSON(http.StatusConflict, gin.H{"error": "Peer feedback round is closed"})
+	case domain.ErrPeerRoundNotClosed:
+		c.JSON(http.StatusConflict, gin.H{"error": "Results are published once the round is closed"})
+	case domain.ErrInvalidPeerRound:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A round needs a name, batch and division, at least 2 minimum respondents and no fewer peers per intern"})
+	case domain.ErrNotEnoughPeers:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The cohort has too few interns for the number of peers per intern"})
+	case domain.ErrPeerAssignmentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feedback assignment not found"})
+	case domain.ErrPeerFeedbackSubmitted:
+		c.JSON(http.StatusConflict, gin.H{"error": "Feedback has already been submitted"})
+	case domain.ErrInvalidRating:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Scores must be between 1 and the rubric scale"})
+	case domain.ErrInvalidReviewAnswers:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Answers must score every rubric criterion exactly once"})
+	case domain.ErrRubricNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Rubric not found"})
+	case domain.ErrInvalidRubric:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A rubric needs a name, a scale between 2 and 10 and named criteria with positive weights"})
+	case domain.ErrRubricInUse:
+		c.JSON(http.StatusConflict, gin.H{"error": "Rubric has already been used by reviews or peer feedback"})
+	case domain.ErrInvalidPeriod:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid period format. Use YYYY-MM"})
+	case domain.ErrOutsideInternshipPeriod:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Period is outside the intern's internship"})
+	case domain.ErrInternNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Intern not found"})
+	case domain.ErrForbidden:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -13,6 +13,7 @@ type Claims struct {
 	UserID   uint   `json:"user_id"`
 	Username string `json:"username"`
 	RoleID   uint   `json:"role_id"`
+	Purpose  string `json:"purpose,omitempty"` // only set on single-purpose tokens such as download links
 	jwt.RegisteredClaims
 }
 
@@ -40,7 +41,7 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		// Parse and validate token
 		token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 			return []byte(jwtSecret), nil
-		})
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 
 		if err != nil || !token.Valid {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
@@ -49,8 +50,9 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		}
 
 		// Extract claims
+		// Single-purpose tokens and tokens without a user and role never authenticate a session
 		claims, ok := token.Claims.(*Claims)
-		if !ok {
+		if !ok || claims.Purpose != "" || claims.UserID == 0 || claims.RoleID == 0 {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
 			c.Abort()
 			return
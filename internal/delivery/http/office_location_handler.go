@@ -0,0 +1,129 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OfficeLocationHandler handles office location HTTP requests
+type OfficeLocationHandler struct {
+	OfficeLocationUsecase domain.OfficeLocationUsecase
+}
+
+// NewOfficeLocationHandler creates a new office location handler
+func NewOfficeLocationHandler(officeLocationUsecase domain.OfficeLocationUsecase) *OfficeLocationHandler {
+	return &OfficeLocationHandler{
+		OfficeLocationUsecase: officeLocationUsecase,
+	}
+}
+
+type officeLocationRequest struct {
+	Division     string   `json:"division"`
+	Name         string   `json:"name" binding:"required"`
+	CIDRs        []string `json:"cidrs"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
+	RadiusMeters int      `json:"radius_meters" binding:"min=0"`
+	Enforcement  string   `json:"enforcement" binding:"required,oneof=reject flag"`
+	Hybrid       bool     `json:"hybrid"`
+}
+
+// CreateLocation handles POST /api/office-locations
+func (h *OfficeLocationHandler) CreateLocation(c *gin.Context) {
+	var req officeLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	location, err := h.OfficeLocationUsecase.CreateLocation(req.Division, req.Name, req.CIDRs, req.Latitude, req.Longitude, req.RadiusMeters, req.Enforcement, req.Hybrid)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Office location created successfully",
+		"data":    location,
+	})
+}
+
+// GetLocations handles GET /api/office-locations
+func (h *OfficeLocationHandler) GetLocations(c *gin.Context) {
+	locations, err := h.OfficeLocationUsecase.GetLocations()
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": locations,
+	})
+}
+
+// GetLocation handles GET /api/office-locations/:id
+func (h *OfficeLocationHandler) GetLocation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid office location ID"})
+		return
+	}
+
+	location, err := h.OfficeLocationUsecase.GetLocationByID(uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": location,
+	})
+}
+
+// UpdateLocation handles PUT /api/office-locations/:id
+func (h *OfficeLocationHandler) UpdateLocation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid office location ID"})
+		return
+	}
+
+	var req officeLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	location, err := h.OfficeLocationUsecase.UpdateLocation(uint(id), req.Division, req.Name, req.CIDRs, req.Latitude, req.Longitude, req.RadiusMeters, req.Enforcement, req.Hybrid)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Office location updated successfully",
+		"data":    location,
+	})
+}
+
+// DeleteLocation handles DELETE /api/office-locations/:id
+func (h *OfficeLocationHandler) DeleteLocation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid office location ID"})
+		return
+	}
+
+	if err := h.OfficeLocationUsecase.DeleteLocation(uint(id)); err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Office location deleted successfully",
+	})
+}
@@ -0,0 +1,216 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PeerFeedbackHandler handles peer feedback HTTP requests
+type PeerFeedbackHandler struct {
+	PeerFeedbackUsecase domain.PeerFeedbackUsecase
+}
+
+// NewPeerFeedbackHandler creates a new peer feedback handler
+func NewPeerFeedbackHandler(peerFeedbackUsecase domain.PeerFeedbackUsecase) *PeerFeedbackHandler {
+	return &PeerFeedbackHandler{
+		PeerFeedbackUsecase: peerFeedbackUsecase,
+	}
+}
+
+// CreateRound handles POST /api/peer-feedback/rounds
+func (h *PeerFeedbackHandler) CreateRound(c *gin.Context) {
+	var req struct {
+		Name               string `json:"name" binding:"required"`
+		Batch              string `json:"batch" binding:"required"`
+		Division           string `json:"division" binding:"required"`
+		Period             string `json:"period" binding:"required"`
+		RubricID           uint   `json:"rubric_id"`
+		Deadline           string `json:"deadline" binding:"required"` // Format: YYYY-MM-DD
+		PeersPerIntern     int    `json:"peers_per_intern" binding:"min=0"`
+		MinRespondents     int    `json:"min_respondents" binding:"min=0"`
+		IncludeInPotential bool   `json:"include_in_potential"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := time.ParseInLocation("2006-01-02", req.Deadline, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD"})
+		return
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	round, err := h.PeerFeedbackUsecase.CreateRound(actorID, domain.PeerFeedbackRoundInput{
+		Name:               req.Name,
+		Batch:              req.Batch,
+		Division:           req.Division,
+		Period:             req.Period,
+		RubricID:           req.RubricID,
+		Deadline:           deadline,
+		PeersPerIntern:     req.PeersPerIntern,
+		MinRespondents:     req.MinRespondents,
+		IncludeInPotential: req.IncludeInPotential,
+	})
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Peer feedback round created successfully",
+		"data":    round,
+	})
+}
+
+// GetRounds handles GET /api/peer-feedback/rounds
+func (h *PeerFeedbackHandler) GetRounds(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	rounds, total, err := h.PeerFeedbackUsecase.GetRounds(page, limit)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        rounds,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetRound handles GET /api/peer-feedback/rounds/:id
+func (h *PeerFeedbackHandler) GetRound(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID"})
+		return
+	}
+
+	round, err := h.PeerFeedbackUsecase.GetRoundByID(uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": round,
+	})
+}
+
+// CloseRound handles PUT /api/peer-feedback/rounds/:id/close
+func (h *PeerFeedbackHandler) CloseRound(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID"})
+		return
+	}
+
+	round, err := h.PeerFeedbackUsecase.CloseRound(uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Peer feedback round closed",
+		"data":    round,
+	})
+}
+
+// GetResults handles GET /api/peer-feedback/rounds/:id/results
+func (h *PeerFeedbackHandler) GetResults(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	results, err := h.PeerFeedbackUsecase.GetResults(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": results,
+	})
+}
+
+// GetAssignments handles GET /api/peer-feedback/assignments
+// Supports pending=true to only list feedback that can still be given
+func (h *PeerFeedbackHandler) GetAssignments(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.PeerFeedbackUsecase.GetAssignments(actorID, actorRoleID, c.Query("pending") == "true")
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": assignments,
+	})
+}
+
+// SubmitFeedback handles POST /api/peer-feedback/assignments/:id/feedback
+// Takes the same body as a mentor review; notes is the overall comment
+func (h *PeerFeedbackHandler) SubmitFeedback(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+		return
+	}
+
+	var req mentorAnswersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.PeerFeedbackUsecase.SubmitFeedback(actorID, actorRoleID, uint(id), req.answerInputs(), req.Notes); err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Feedback submitted successfully",
+	})
+}
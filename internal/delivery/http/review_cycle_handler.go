@@ -0,0 +1,201 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReviewCycleHandler handles review cycle HTTP requests
+type ReviewCycleHandler struct {
+	ReviewCycleUsecase domain.ReviewCycleUsecase
+}
+
+// NewReviewCycleHandler creates a new review cycle handler
+func NewReviewCycleHandler(reviewCycleUsecase domain.ReviewCycleUsecase) *ReviewCycleHandler {
+	return &ReviewCycleHandler{
+		ReviewCycleUsecase: reviewCycleUsecase,
+	}
+}
+
+// OpenCycle handles POST /api/review-cycles
+func (h *ReviewCycleHandler) OpenCycle(c *gin.Context) {
+	var req struct {
+		Period   string `json:"period" binding:"required"`
+		Deadline string `json:"deadline" binding:"required"` // Format: YYYY-MM-DD
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := time.ParseInLocation("2006-01-02", req.Deadline, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD"})
+		return
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	cycle, err := h.ReviewCycleUsecase.OpenCycle(actorID, req.Period, deadline)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Review cycle opened successfully",
+		"data":    cycle,
+	})
+}
+
+// GetCycles handles GET /api/review-cycles
+func (h *ReviewCycleHandler) GetCycles(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	cycles, total, err := h.ReviewCycleUsecase.GetCycles(page, limit)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        cycles,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetCycle handles GET /api/review-cycles/:id
+func (h *ReviewCycleHandler) GetCycle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review cycle ID"})
+		return
+	}
+
+	cycle, err := h.ReviewCycleUsecase.GetCycleByID(uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": cycle,
+	})
+}
+
+// UpdateDeadline handles PUT /api/review-cycles/:id
+func (h *ReviewCycleHandler) UpdateDeadline(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review cycle ID"})
+		return
+	}
+
+	var req struct {
+		Deadline string `json:"deadline" binding:"required"` // Format: YYYY-MM-DD
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := time.ParseInLocation("2006-01-02", req.Deadline, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD"})
+		return
+	}
+
+	cycle, err := h.ReviewCycleUsecase.UpdateDeadline(uint(id), deadline)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Review cycle updated successfully",
+		"data":    cycle,
+	})
+}
+
+// CloseCycle handles PUT /api/review-cycles/:id/close
+func (h *ReviewCycleHandler) CloseCycle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review cycle ID"})
+		return
+	}
+
+	cycle, err := h.ReviewCycleUsecase.CloseCycle(uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Review cycle closed",
+		"data":    cycle,
+	})
+}
+
+// ReopenForIntern handles POST /api/review-cycles/:id/reopenings
+func (h *ReviewCycleHandler) ReopenForIntern(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review cycle ID"})
+		return
+	}
+
+	var req struct {
+		InternID uint   `json:"intern_id" binding:"required"`
+		Deadline string `json:"deadline" binding:"required"` // Format: YYYY-MM-DD
+		Reason   string `json:"reason" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := time.ParseInLocation("2006-01-02", req.Deadline, time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD"})
+		return
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	reopening, err := h.ReviewCycleUsecase.ReopenForIntern(actorID, uint(id), req.InternID, deadline, req.Reason)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Review reopened for the intern",
+		"data":    reopening,
+	})
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReviewRubricHandler handles review rubric HTTP requests
+type ReviewRubricHandler struct {
+	ReviewRubricUsecase domain.ReviewRubricUsecase
+}
+
+// NewReviewRubricHandler creates a new review rubric handler
+func NewReviewRubricHandler(reviewRubricUsecase domain.ReviewRubricUsecase) *ReviewRubricHandler {
+	return &ReviewRubricHandler{
+		ReviewRubricUsecase: reviewRubricUsecase,
+	}
+}
+
+// CreateRubric handles POST /api/review-rubrics
+// Each call publishes a new version; existing versions are never changed.
+func (h *ReviewRubricHandler) CreateRubric(c *gin.Context) {
+	var req struct {
+		Name            string `json:"name" binding:"required"`
+		Division        string `json:"division"`
+		EffectivePeriod string `json:"effective_period" binding:"required"`
+		Scale           int    `json:"scale" binding:"required"`
+		Criteria        []struct {
+			Name        string  `json:"name" binding:"required"`
+			Description string  `json:"description"`
+			Weight      float64 `json:"weight" binding:"required"`
+		} `json:"criteria" binding:"required,min=1,dive"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	criteria := make([]domain.RubricCriterionInput, 0, len(req.Criteria))
+	for _, criterion := range req.Criteria {
+		criteria = append(criteria, domain.RubricCriterionInput{
+			Name:        criterion.Name,
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+		})
+	}
+
+	rubric, err := h.ReviewRubricUsecase.CreateRubric(actorID, req.Name, req.Division, req.EffectivePeriod, req.Scale, criteria)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Rubric created successfully",
+		"data":    rubric,
+	})
+}
+
+// GetRubrics handles GET /api/review-rubrics
+// Supports a division filter
+func (h *ReviewRubricHandler) GetRubrics(c *gin.Context) {
+	rubrics, err := h.ReviewRubricUsecase.GetRubrics(c.Query("division"))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": rubrics,
+	})
+}
+
+// GetRubric handles GET /api/review-rubrics/:id
+func (h *ReviewRubricHandler) GetRubric(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rubric ID"})
+		return
+	}
+
+	rubric, err := h.ReviewRubricUsecase.GetRubricByID(uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": rubric,
+	})
+}
+
+// ResolveRubric handles GET /api/review-rubrics/resolve
+// Returns the rubric a review of intern_id for period would use
+func (h *ReviewRubricHandler) ResolveRubric(c *gin.Context) {
+	internID, err := strconv.ParseUint(c.Query("intern_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	rubric, err := h.ReviewRubricUsecase.GetRubricForIntern(actorID, actorRoleID, uint(internID), c.Query("period"))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": rubric,
+	})
+}
+
+// DeleteRubric handles DELETE /api/review-rubrics/:id
+func (h *ReviewRubricHandler) DeleteRubric(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rubric ID"})
+		return
+	}
+
+	if err := h.ReviewRubricUsecase.DeleteRubric(uint(id)); err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Rubric deleted successfully",
+	})
+}
@@ -0,0 +1,205 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SelfAssessmentHandler handles intern self-assessment HTTP requests
+type SelfAssessmentHandler struct {
+	SelfAssessmentUsecase domain.SelfAssessmentUsecase
+}
+
+// NewSelfAssessmentHandler creates a new self-assessment handler
+func NewSelfAssessmentHandler(selfAssessmentUsecase domain.SelfAssessmentUsecase) *SelfAssessmentHandler {
+	return &SelfAssessmentHandler{
+		SelfAssessmentUsecase: selfAssessmentUsecase,
+	}
+}
+
+// CreateAssessment handles POST /api/self-assessments
+// Saves a draft; answers must score every criterion of the rubric of the period
+func (h *SelfAssessmentHandler) CreateAssessment(c *gin.Context) {
+	var req struct {
+		Period string `json:"period" binding:"required"`
+		mentorAnswersRequest
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	assessment, err := h.SelfAssessmentUsecase.CreateAssessment(actorID, actorRoleID, req.Period, req.answerInputs(), req.Notes)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Self-assessment draft saved successfully",
+		"data":    assessment,
+	})
+}
+
+// GetAssessments handles GET /api/self-assessments
+// Supports intern_id, period and status filters
+func (h *SelfAssessmentHandler) GetAssessments(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter := domain.SelfAssessmentFilter{
+		Period: c.Query("period"),
+		Status: c.Query("status"),
+	}
+	if v := c.Query("intern_id"); v != "" {
+		internID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		filter.InternID = uint(internID)
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	assessments, total, err := h.SelfAssessmentUsecase.GetAssessments(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        assessments,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetAssessment handles GET /api/self-assessments/:id
+func (h *SelfAssessmentHandler) GetAssessment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid self-assessment ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	assessment, err := h.SelfAssessmentUsecase.GetAssessmentByID(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": assessment,
+	})
+}
+
+// UpdateAssessment handles PUT /api/self-assessments/:id
+func (h *SelfAssessmentHandler) UpdateAssessment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid self-assessment ID"})
+		return
+	}
+
+	var req mentorAnswersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	assessment, err := h.SelfAssessmentUsecase.UpdateAssessment(actorID, actorRoleID, uint(id), req.answerInputs(), req.Notes)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Self-assessment updated successfully",
+		"data":    assessment,
+	})
+}
+
+// SubmitAssessment handles PUT /api/self-assessments/:id/submit
+func (h *SelfAssessmentHandler) SubmitAssessment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid self-assessment ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	assessment, err := h.SelfAssessmentUsecase.SubmitAssessment(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Self-assessment submitted successfully",
+		"data":    assessment,
+	})
+}
+
+// CompareWithReview handles GET /api/mentor-reviews/:id/comparison
+// Returns the review next to the intern's self-assessment with per-criterion gaps
+func (h *SelfAssessmentHandler) CompareWithReview(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	comparison, err := h.SelfAssessmentUsecase.CompareWithReview(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": comparison,
+	})
+}
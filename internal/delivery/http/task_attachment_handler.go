@@ -0,0 +1,174 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead leaves room for multipart boundaries and headers on top of the file size limit
+const multipartOverhead = 1 << 20
+
+// TaskAttachmentHandler handles task attachment HTTP requests
+type TaskAttachmentHandler struct {
+	TaskAttachmentUsecase domain.TaskAttachmentUsecase
+	MaxUploadSize         int64
+}
+
+// NewTaskAttachmentHandler creates a new task attachment handler
+func NewTaskAttachmentHandler(taskAttachmentUsecase domain.TaskAttachmentUsecase, maxUploadSize int64) *TaskAttachmentHandler {
+	return &TaskAttachmentHandler{
+		TaskAttachmentUsecase: taskAttachmentUsecase,
+		MaxUploadSize:         maxUploadSize,
+	}
+}
+
+// UploadAttachment handles POST /api/tasks/:id/attachments (multipart form field "file")
+func (h *TaskAttachmentHandler) UploadAttachment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.MaxUploadSize+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required or exceeds the size limit"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	attachment, err := h.TaskAttachmentUsecase.Upload(actorID, actorRoleID, uint(taskID), header.Filename, header.Size, file)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Attachment uploaded successfully",
+		"data":    attachment,
+	})
+}
+
+// GetAttachments handles GET /api/tasks/:id/attachments
+func (h *TaskAttachmentHandler) GetAttachments(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	attachments, err := h.TaskAttachmentUsecase.GetAttachments(actorID, actorRoleID, uint(taskID))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": attachments,
+	})
+}
+
+// GetDownloadLink handles GET /api/tasks/:id/attachments/:attachmentId/link
+// Returns a short-lived link that can be opened without the Authorization header
+func (h *TaskAttachmentHandler) GetDownloadLink(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	attachmentID, err := strconv.ParseUint(c.Param("attachmentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	token, expiresAt, err := h.TaskAttachmentUsecase.CreateDownloadToken(actorID, actorRoleID, uint(taskID), uint(attachmentID))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"url":        fmt.Sprintf("/files/attachments/%d?token=%s", attachmentID, token),
+		"expires_at": expiresAt,
+	})
+}
+
+// DownloadAttachment handles GET /files/attachments/:attachmentId?token=...
+func (h *TaskAttachmentHandler) DownloadAttachment(c *gin.Context) {
+	attachmentID, err := strconv.ParseUint(c.Param("attachmentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	attachment, file, err := h.TaskAttachmentUsecase.OpenDownload(uint(attachmentID), c.Query("token"))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+	defer file.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, file, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", attachment.FileName),
+		"X-Checksum-SHA256":   attachment.Checksum,
+	})
+}
+
+// DeleteAttachment handles DELETE /api/tasks/:id/attachments/:attachmentId
+func (h *TaskAttachmentHandler) DeleteAttachment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	attachmentID, err := strconv.ParseUint(c.Param("attachmentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskAttachmentUsecase.DeleteAttachment(actorID, actorRoleID, uint(taskID), uint(attachmentID)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Attachment deleted successfully",
+	})
+}
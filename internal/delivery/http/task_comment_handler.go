@@ -0,0 +1,184 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaskCommentHandler handles task discussion HTTP requests
+type TaskCommentHandler struct {
+	TaskCommentUsecase domain.TaskCommentUsecase
+}
+
+// NewTaskCommentHandler creates a new task comment handler
+func NewTaskCommentHandler(taskCommentUsecase domain.TaskCommentUsecase) *TaskCommentHandler {
+	return &TaskCommentHandler{
+		TaskCommentUsecase: taskCommentUsecase,
+	}
+}
+
+// CreateComment handles POST /api/tasks/:id/comments
+func (h *TaskCommentHandler) CreateComment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		Body string `json:"body" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	comment, err := h.TaskCommentUsecase.AddComment(actorID, actorRoleID, uint(taskID), req.Body)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Comment added successfully",
+		"data":    comment,
+	})
+}
+
+// GetComments handles GET /api/tasks/:id/comments
+func (h *TaskCommentHandler) GetComments(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	comments, err := h.TaskCommentUsecase.GetComments(actorID, actorRoleID, uint(taskID))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": comments,
+	})
+}
+
+// UpdateComment handles PUT /api/tasks/:id/comments/:commentId
+func (h *TaskCommentHandler) UpdateComment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	commentID, err := strconv.ParseUint(c.Param("commentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	var req struct {
+		Body string `json:"body" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	comment, err := h.TaskCommentUsecase.UpdateComment(actorID, actorRoleID, uint(taskID), uint(commentID), req.Body)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment updated successfully",
+		"data":    comment,
+	})
+}
+
+// DeleteComment handles DELETE /api/tasks/:id/comments/:commentId
+func (h *TaskCommentHandler) DeleteComment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	commentID, err := strconv.ParseUint(c.Param("commentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskCommentUsecase.DeleteComment(actorID, actorRoleID, uint(taskID), uint(commentID)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment deleted successfully",
+	})
+}
+
+// GetMentions handles GET /api/mentions
+// Lists comments in which the current user was mentioned
+func (h *TaskCommentHandler) GetMentions(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	comments, total, err := h.TaskCommentUsecase.GetMentions(actorID, page, limit)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        comments,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
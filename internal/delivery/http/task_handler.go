@@ -0,0 +1,613 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaskHandler handles task-related HTTP requests
+type TaskHandler struct {
+	TaskUsecase domain.TaskUsecase
+}
+
+// NewTaskHandler creates a new task handler
+func NewTaskHandler(taskUsecase domain.TaskUsecase) *TaskHandler {
+	return &TaskHandler{
+		TaskUsecase: taskUsecase,
+	}
+}
+
+// CreateTask handles POST /api/tasks
+func (h *TaskHandler) CreateTask(c *gin.Context) {
+	var req struct {
+		InternID    uint   `json:"intern_id" binding:"required"`
+		Title       string `json:"title" binding:"required"`
+		Description string `json:"description"`
+		Deadline    string `json:"deadline" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := parseDeadline(req.Deadline)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD or RFC3339"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.TaskUsecase.CreateTask(actorID, actorRoleID, req.InternID, req.Title, req.Description, deadline)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Task created successfully",
+		"data":    task,
+	})
+}
+
+// GetTasks handles GET /api/tasks
+// Supports filters: intern_id, status, deadline_from, deadline_to (YYYY-MM-DD)
+func (h *TaskHandler) GetTasks(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter, err := parseTaskFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	tasks, total, err := h.TaskUsecase.GetAllTasks(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        tasks,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetOverdueTasks handles GET /api/tasks/overdue
+// Accepts the same filters as GetTasks
+func (h *TaskHandler) GetOverdueTasks(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter, err := parseTaskFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	tasks, total, err := h.TaskUsecase.GetOverdueTasks(actorID, actorRoleID, filter, page, limit)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        tasks,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetTask handles GET /api/tasks/:id
+func (h *TaskHandler) GetTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.TaskUsecase.GetTaskByID(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": task,
+	})
+}
+
+// UpdateTask handles PUT /api/tasks/:id
+func (h *TaskHandler) UpdateTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		Title       string `json:"title" binding:"required"`
+		Description string `json:"description"`
+		Deadline    string `json:"deadline" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := parseDeadline(req.Deadline)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD or RFC3339"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.TaskUsecase.UpdateTask(actorID, actorRoleID, uint(id), req.Title, req.Description, deadline)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task updated successfully",
+		"data":    task,
+	})
+}
+
+// DeleteTask handles DELETE /api/tasks/:id
+func (h *TaskHandler) DeleteTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskUsecase.DeleteTask(actorID, actorRoleID, uint(id)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task deleted successfully",
+	})
+}
+
+// ChangeTaskStatus handles PUT /api/tasks/:id/status
+func (h *TaskHandler) ChangeTaskStatus(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		Status string `json:"status" binding:"required,oneof=todo in_progress submitted done"`
+		Reason string `json:"reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.TaskUsecase.ChangeStatus(actorID, actorRoleID, uint(id), req.Status, req.Reason)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task status updated successfully",
+		"data":    task,
+	})
+}
+
+// GetTaskHistory handles GET /api/tasks/:id/history
+func (h *TaskHandler) GetTaskHistory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	history, err := h.TaskUsecase.GetStatusHistory(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": history,
+	})
+}
+
+// GradeTask handles PUT /api/tasks/:id/grade
+func (h *TaskHandler) GradeTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		QualityScore *int   `json:"quality_score" binding:"required,min=0,max=100"`
+		Feedback     string `json:"feedback"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.TaskUsecase.GradeTask(actorID, actorRoleID, uint(id), *req.QualityScore, req.Feedback)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task graded successfully",
+		"data":    task,
+	})
+}
+
+// GetTaskGrades handles GET /api/tasks/:id/grades
+func (h *TaskHandler) GetTaskGrades(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	grades, err := h.TaskUsecase.GetGrades(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": grades,
+	})
+}
+
+// CreateSubtask handles POST /api/tasks/:id/subtasks
+func (h *TaskHandler) CreateSubtask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		Title       string `json:"title" binding:"required"`
+		Description string `json:"description"`
+		Deadline    string `json:"deadline" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := parseDeadline(req.Deadline)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deadline format. Use YYYY-MM-DD or RFC3339"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.TaskUsecase.CreateSubtask(actorID, actorRoleID, uint(id), req.Title, req.Description, deadline)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Subtask created successfully",
+		"data":    task,
+	})
+}
+
+// GetSubtasks handles GET /api/tasks/:id/subtasks
+func (h *TaskHandler) GetSubtasks(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.TaskUsecase.GetSubtasks(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": tasks,
+	})
+}
+
+// GetDependencies handles GET /api/tasks/:id/dependencies
+func (h *TaskHandler) GetDependencies(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	blockedBy, blocking, err := h.TaskUsecase.GetDependencies(actorID, actorRoleID, uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"blocked_by": blockedBy,
+		"blocking":   blocking,
+	})
+}
+
+// AddDependency handles POST /api/tasks/:id/dependencies
+func (h *TaskHandler) AddDependency(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		BlockedByID uint `json:"blocked_by_id" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskUsecase.AddDependency(actorID, actorRoleID, uint(id), req.BlockedByID); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Dependency added successfully",
+	})
+}
+
+// RemoveDependency handles DELETE /api/tasks/:id/dependencies/:blockerId
+func (h *TaskHandler) RemoveDependency(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	blockerID, err := strconv.ParseUint(c.Param("blockerId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid blocker task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskUsecase.RemoveDependency(actorID, actorRoleID, uint(id), uint(blockerID)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Dependency removed successfully",
+	})
+}
+
+// parseDeadline accepts either a plain date (treated as end of that day) or an RFC3339 timestamp
+func parseDeadline(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// parseTaskFilter reads task list filters from the query string
+func parseTaskFilter(c *gin.Context) (domain.TaskFilter, error) {
+	var filter domain.TaskFilter
+
+	if v := c.Query("intern_id"); v != "" {
+		internID, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return filter, errInvalidQuery("intern_id")
+		}
+		filter.InternID = uint(internID)
+	}
+
+	filter.Status = c.Query("status")
+
+	if v := c.Query("deadline_from"); v != "" {
+		from, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return filter, errInvalidQuery("deadline_from")
+		}
+		filter.DeadlineFrom = &from
+	}
+
+	if v := c.Query("deadline_to"); v != "" {
+		to, err := parseDeadline(v)
+		if err != nil {
+			return filter, errInvalidQuery("deadline_to")
+		}
+		filter.DeadlineTo = &to
+	}
+
+	return filter, nil
+}
+
+// errInvalidQuery reports a malformed query string parameter
+func errInvalidQuery(param string) error {
+	return fmt.Errorf("Invalid %s", param)
+}
+
+// respondTaskError maps task usecase errors to HTTP responses
+func respondTaskError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrTaskNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+	case domain.ErrCommentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+	case domain.ErrAttachmentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Attachment not found"})
+	case domain.ErrTemplateNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Task template not found"})
+	case domain.ErrNoMatchingInterns:
+		c.JSON(http.StatusNotFound, gin.H{"error": "No interns match the given batch and division"})
+	case domain.ErrRecurrenceNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Recurrence not found"})
+	case domain.ErrInvalidRecurrence:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurrence rule"})
+	case domain.ErrCohortRequired:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Batch or division is required"})
+	case domain.ErrTimeEntryNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
+	case domain.ErrNoRunningTimer:
+		c.JSON(http.StatusNotFound, gin.H{"error": "No running timer"})
+	case domain.ErrTimerAlreadyRunning:
+		c.JSON(http.StatusConflict, gin.H{"error": "A timer is already running"})
+	case domain.ErrInvalidDuration:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Duration must be between 1 and 1440 minutes and not end in the future"})
+	case domain.ErrTimeEntryNotEditable:
+		c.JSON(http.StatusConflict, gin.H{"error": "Time entry can no longer be changed"})
+	case domain.ErrInternNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Intern not found"})
+	case domain.ErrForbidden:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+	case domain.ErrInvalidTaskTransition:
+		c.JSON(http.StatusConflict, gin.H{"error": "Status transition not allowed"})
+	case domain.ErrReasonRequired:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A reason is required for this transition"})
+	case domain.ErrTaskBlocked:
+		c.JSON(http.StatusConflict, gin.H{"error": "Task is blocked by unfinished tasks"})
+	case domain.ErrSubtasksIncomplete:
+		c.JSON(http.StatusConflict, gin.H{"error": "All subtasks must be done first"})
+	case domain.ErrDependencyCycle:
+		c.JSON(http.StatusConflict, gin.H{"error": "Dependency would create a cycle"})
+	case domain.ErrInvalidDependency:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task dependency"})
+	case domain.ErrTaskNotGradable:
+		c.JSON(http.StatusConflict, gin.H{"error": "Only submitted or completed tasks can be graded"})
+	case domain.ErrInvalidScore:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Quality score must be between 0 and 100"})
+	case domain.ErrFileTooLarge:
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File exceeds the upload size limit"})
+	case domain.ErrFileTypeNotAllowed:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "File type is not allowed"})
+	case domain.ErrInvalidDownloadLink:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Download link is invalid or has expired"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
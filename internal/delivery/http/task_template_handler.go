@@ -0,0 +1,303 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaskTemplateHandler handles task template and recurrence HTTP requests
+type TaskTemplateHandler struct {
+	TaskTemplateUsecase   domain.TaskTemplateUsecase
+	TaskRecurrenceUsecase domain.TaskRecurrenceUsecase
+}
+
+// NewTaskTemplateHandler creates a new task template handler
+func NewTaskTemplateHandler(taskTemplateUsecase domain.TaskTemplateUsecase, taskRecurrenceUsecase domain.TaskRecurrenceUsecase) *TaskTemplateHandler {
+	return &TaskTemplateHandler{
+		TaskTemplateUsecase:   taskTemplateUsecase,
+		TaskRecurrenceUsecase: taskRecurrenceUsecase,
+	}
+}
+
+type taskTemplateRequest struct {
+	Title              string `json:"title" binding:"required"`
+	Description        string `json:"description"`
+	DeadlineOffsetDays int    `json:"deadline_offset_days" binding:"min=0"`
+}
+
+// CreateTemplate handles POST /api/task-templates
+func (h *TaskTemplateHandler) CreateTemplate(c *gin.Context) {
+	var req taskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.TaskTemplateUsecase.CreateTemplate(actorID, req.Title, req.Description, req.DeadlineOffsetDays)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Task template created successfully",
+		"data":    template,
+	})
+}
+
+// GetTemplates handles GET /api/task-templates
+func (h *TaskTemplateHandler) GetTemplates(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	templates, total, err := h.TaskTemplateUsecase.GetAllTemplates(page, limit)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	totalPages := (int(total) + limit - 1) / limit
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":        templates,
+		"total":       total,
+		"page":        page,
+		"limit":       limit,
+		"total_pages": totalPages,
+	})
+}
+
+// GetTemplate handles GET /api/task-templates/:id
+func (h *TaskTemplateHandler) GetTemplate(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	template, err := h.TaskTemplateUsecase.GetTemplateByID(uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": template,
+	})
+}
+
+// UpdateTemplate handles PUT /api/task-templates/:id
+func (h *TaskTemplateHandler) UpdateTemplate(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	var req taskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.TaskTemplateUsecase.UpdateTemplate(actorID, actorRoleID, uint(id), req.Title, req.Description, req.DeadlineOffsetDays)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task template updated successfully",
+		"data":    template,
+	})
+}
+
+// DeleteTemplate handles DELETE /api/task-templates/:id
+func (h *TaskTemplateHandler) DeleteTemplate(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskTemplateUsecase.DeleteTemplate(actorID, actorRoleID, uint(id)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task template deleted successfully",
+	})
+}
+
+// AssignTemplate handles POST /api/task-templates/:id/assign
+// Creates a task for every intern matching the batch and/or division
+func (h *TaskTemplateHandler) AssignTemplate(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	var req struct {
+		Batch    string `json:"batch"`
+		Division string `json:"division"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.TaskTemplateUsecase.AssignTemplate(actorID, actorRoleID, uint(id), req.Batch, req.Division)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Tasks assigned successfully",
+		"total":   len(tasks),
+		"data":    tasks,
+	})
+}
+
+// CreateRecurrence handles POST /api/task-templates/:id/recurrences
+func (h *TaskTemplateHandler) CreateRecurrence(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	var req struct {
+		Batch      string `json:"batch"`
+		Division   string `json:"division"`
+		Frequency  string `json:"frequency" binding:"required,oneof=daily weekly monthly"`
+		Weekday    *int   `json:"weekday"`
+		DayOfMonth *int   `json:"day_of_month"`
+		StartDate  string `json:"start_date"`
+		EndDate    string `json:"end_date"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Start today unless told otherwise
+	startDate := time.Now()
+	if req.StartDate != "" {
+		startDate, err = time.ParseInLocation("2006-01-02", req.StartDate, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid start_date format. Use YYYY-MM-DD"})
+			return
+		}
+	}
+
+	var endDate *time.Time
+	if req.EndDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", req.EndDate, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid end_date format. Use YYYY-MM-DD"})
+			return
+		}
+		endDate = &parsed
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	recurrence, err := h.TaskRecurrenceUsecase.CreateRecurrence(actorID, actorRoleID, uint(id), req.Batch, req.Division, req.Frequency, req.Weekday, req.DayOfMonth, startDate, endDate)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Recurrence created successfully",
+		"data":    recurrence,
+	})
+}
+
+// GetRecurrences handles GET /api/task-templates/:id/recurrences
+func (h *TaskTemplateHandler) GetRecurrences(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	recurrences, err := h.TaskRecurrenceUsecase.GetRecurrences(uint(id))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": recurrences,
+	})
+}
+
+// DeactivateRecurrence handles DELETE /api/task-templates/:id/recurrences/:recurrenceId
+func (h *TaskTemplateHandler) DeactivateRecurrence(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	recurrenceID, err := strconv.ParseUint(c.Param("recurrenceId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurrence ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TaskRecurrenceUsecase.DeactivateRecurrence(actorID, actorRoleID, uint(id), uint(recurrenceID)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Recurrence deactivated successfully",
+	})
+}
@@ -0,0 +1,302 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TimeEntryHandler handles time logging and timesheet HTTP requests
+type TimeEntryHandler struct {
+	TimeEntryUsecase domain.TimeEntryUsecase
+}
+
+// NewTimeEntryHandler creates a new time entry handler
+func NewTimeEntryHandler(timeEntryUsecase domain.TimeEntryUsecase) *TimeEntryHandler {
+	return &TimeEntryHandler{
+		TimeEntryUsecase: timeEntryUsecase,
+	}
+}
+
+// StartTimer handles POST /api/tasks/:id/timer/start
+func (h *TimeEntryHandler) StartTimer(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+
+	// The body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.TimeEntryUsecase.StartTimer(actorID, actorRoleID, uint(taskID), req.Note)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Timer started",
+		"data":    entry,
+	})
+}
+
+// StopTimer handles POST /api/time-entries/stop
+func (h *TimeEntryHandler) StopTimer(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.TimeEntryUsecase.StopTimer(actorID, actorRoleID)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Timer stopped",
+		"data":    entry,
+	})
+}
+
+// LogTime handles POST /api/tasks/:id/time-entries
+func (h *TimeEntryHandler) LogTime(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req struct {
+		StartedAt       time.Time `json:"started_at" binding:"required"`
+		DurationMinutes int       `json:"duration_minutes" binding:"required"`
+		Note            string    `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.TimeEntryUsecase.LogTime(actorID, actorRoleID, uint(taskID), req.StartedAt, req.DurationMinutes, req.Note)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Time logged successfully",
+		"data":    entry,
+	})
+}
+
+// GetTaskEntries handles GET /api/tasks/:id/time-entries
+func (h *TimeEntryHandler) GetTaskEntries(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	entries, total, err := h.TimeEntryUsecase.GetTaskEntries(actorID, actorRoleID, uint(taskID))
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":          entries,
+		"total_minutes": total,
+	})
+}
+
+// DeleteEntry handles DELETE /api/time-entries/:id
+func (h *TimeEntryHandler) DeleteEntry(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.TimeEntryUsecase.DeleteEntry(actorID, actorRoleID, uint(id)); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Time entry deleted successfully",
+	})
+}
+
+// ReviewEntry handles PUT /api/time-entries/:id/review
+func (h *TimeEntryHandler) ReviewEntry(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	var req struct {
+		Approve *bool `json:"approve" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.TimeEntryUsecase.ReviewEntry(actorID, actorRoleID, uint(id), *req.Approve)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Time entry reviewed successfully",
+		"data":    entry,
+	})
+}
+
+// GetTimesheet handles GET /api/timesheets?intern_id=&week=YYYY-MM-DD
+// Interns may omit intern_id to get their own timesheet. Instead of week, an explicit
+// from/to date range can be given.
+func (h *TimeEntryHandler) GetTimesheet(c *gin.Context) {
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	internID := actorID
+	if value := c.Query("intern_id"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid intern_id"})
+			return
+		}
+		internID = uint(id)
+	}
+
+	from, to, err := parseTimesheetPeriod(c.Query("week"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	timesheet, err := h.TimeEntryUsecase.GetTimesheet(actorID, actorRoleID, internID, from, to)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": timesheet,
+	})
+}
+
+// ApproveTimesheet handles PUT /api/timesheets/approve
+// Approves every pending entry of the intern in the given week
+func (h *TimeEntryHandler) ApproveTimesheet(c *gin.Context) {
+	var req struct {
+		InternID uint   `json:"intern_id" binding:"required"`
+		Week     string `json:"week"`
+		From     string `json:"from"`
+		To       string `json:"to"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := parseTimesheetPeriod(req.Week, req.From, req.To)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorID, actorRoleID, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	approved, err := h.TimeEntryUsecase.ApproveTimesheet(actorID, actorRoleID, req.InternID, from, to)
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Timesheet approved successfully",
+		"approved": approved,
+	})
+}
+
+// parseTimesheetPeriod resolves the [from, to) range of a timesheet.
+// week is any date in the week (weeks start on Monday); from/to are inclusive dates.
+// Without any of them the current week is used.
+func parseTimesheetPeriod(week, from, to string) (time.Time, time.Time, error) {
+	if from != "" || to != "" {
+		start, err := time.ParseInLocation("2006-01-02", from, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidQuery("from")
+		}
+		end, err := time.ParseInLocation("2006-01-02", to, time.Local)
+		if err != nil || end.Before(start) {
+			return time.Time{}, time.Time{}, errInvalidQuery("to")
+		}
+		return start, end.AddDate(0, 0, 1), nil
+	}
+
+	day := time.Now()
+	if week != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", week, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidQuery("week")
+		}
+		day = parsed
+	}
+
+	y, m, d := day.Date()
+	offset := (int(day.Weekday()) + 6) % 7
+	start := time.Date(y, m, d-offset, 0, 0, 0, 0, time.Local)
+	return start, start.AddDate(0, 0, 7), nil
+}
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WorkScheduleHandler handles work schedule HTTP requests
+type WorkScheduleHandler struct {
+	WorkScheduleUsecase domain.WorkScheduleUsecase
+}
+
+// NewWorkScheduleHandler creates a new work schedule handler
+func NewWorkScheduleHandler(workScheduleUsecase domain.WorkScheduleUsecase) *WorkScheduleHandler {
+	return &WorkScheduleHandler{
+		WorkScheduleUsecase: workScheduleUsecase,
+	}
+}
+
+type workScheduleRequest struct {
+	Division           string `json:"division"`
+	Batch              string `json:"batch"`
+	StartTime          string `json:"start_time" binding:"required"`
+	EndTime            string `json:"end_time" binding:"required"`
+	GracePeriodMinutes int    `json:"grace_period_minutes" binding:"min=0"`
+	WorkingDays        []int  `json:"working_days" binding:"required"` // 0 = Sunday ... 6 = Saturday
+}
+
+// CreateSchedule handles POST /api/work-schedules
+func (h *WorkScheduleHandler) CreateSchedule(c *gin.Context) {
+	var req workScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := h.WorkScheduleUsecase.CreateSchedule(req.Division, req.Batch, req.StartTime, req.EndTime, req.GracePeriodMinutes, req.WorkingDays)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Work schedule created successfully",
+		"data":    schedule,
+	})
+}
+
+// GetSchedules handles GET /api/work-schedules
+func (h *WorkScheduleHandler) GetSchedules(c *gin.Context) {
+	schedules, err := h.WorkScheduleUsecase.GetSchedules()
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": schedules,
+	})
+}
+
+// GetSchedule handles GET /api/work-schedules/:id
+func (h *WorkScheduleHandler) GetSchedule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid schedule ID"})
+		return
+	}
+
+	schedule, err := h.WorkScheduleUsecase.GetScheduleByID(uint(id))
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": schedule,
+	})
+}
+
+// GetMySchedule handles GET /api/attendance/schedule
+// Returns the schedule that applies to the logged-in intern
+func (h *WorkScheduleHandler) GetMySchedule(c *gin.Context) {
+	actorID, _, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.WorkScheduleUsecase.GetScheduleForIntern(actorID)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": schedule,
+	})
+}
+
+// UpdateSchedule handles PUT /api/work-schedules/:id
+func (h *WorkScheduleHandler) UpdateSchedule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid schedule ID"})
+		return
+	}
+
+	var req workScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := h.WorkScheduleUsecase.UpdateSchedule(uint(id), req.Division, req.Batch, req.StartTime, req.EndTime, req.GracePeriodMinutes, req.WorkingDays)
+	if err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Work schedule updated successfully",
+		"data":    schedule,
+	})
+}
+
+// DeleteSchedule handles DELETE /api/work-schedules/:id
+func (h *WorkScheduleHandler) DeleteSchedule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid schedule ID"})
+		return
+	}
+
+	if err := h.WorkScheduleUsecase.DeleteSchedule(uint(id)); err != nil {
+		respondAttendanceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Work schedule deleted successfully",
+	})
+}
@@ -2,18 +2,140 @@ package domain
 
 import "time"
 
-// Attendance represents daily attendance records for interns
+// Attendance statuses
+const (
+	AttendanceHadir     = "hadir"
+	AttendanceTerlambat = "terlambat" // present, but checked in after the grace period
+	AttendanceIzin      = "izin"
+	AttendanceAlpha     = "alpha"
+)
+
+// Calendar day statuses used next to the attendance statuses for days without a record
+const (
+	CalendarOutsideInternship = "outside_internship"
+	CalendarHoliday           = "holiday"
+	CalendarDayOff            = "day_off"  // not a working day in the intern's schedule
+	CalendarUpcoming          = "upcoming" // still to come, or today before office hours end
+	CalendarMissing           = "missing"  // a past working day not marked yet
+)
+
+// Attendance represents daily attendance records for interns.
+// There is at most one record per intern per day.
 type Attendance struct {
-	ID          uint       `gorm:"primaryKey" json:"id"`
-	InternID    uint       `gorm:"not null" json:"intern_id"`
-	Intern      User       `gorm:"foreignKey:InternID" json:"intern"`
-	Date        time.Time  `gorm:"not null" json:"date"`
-	Status      string     `gorm:"not null" json:"status"` // hadir, izin, alpha
-	CheckInTime *time.Time `json:"check_in_time"`
-	CreatedAt   time.Time  `json:"created_at"`
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	InternID      uint       `gorm:"not null;uniqueIndex:idx_attendance_intern_date,priority:1" json:"intern_id"`
+	Intern        User       `gorm:"foreignKey:InternID" json:"intern"`
+	Date          time.Time  `gorm:"type:date;not null;index;uniqueIndex:idx_attendance_intern_date,priority:2" json:"date"`
+	Status        string     `gorm:"not null" json:"status"` // hadir, terlambat, izin, alpha
+	CheckInTime   *time.Time `json:"check_in_time"`
+	LateMinutes   int        `gorm:"not null;default:0" json:"late_minutes"` // minutes after the scheduled start
+	CheckOutTime  *time.Time `json:"check_out_time"`
+	WorkedMinutes int        `gorm:"not null;default:0" json:"worked_minutes"` // set at check-out
+	ClientIP      string     `gorm:"not null;default:''" json:"client_ip"`
+	Latitude      *float64   `json:"latitude"`
+	Longitude     *float64   `json:"longitude"`
+	IsRemote      bool       `gorm:"not null;default:false;index" json:"is_remote"` // checked in outside every office, for review
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
 
 // TableName specifies the table name for Attendance model
 func (Attendance) TableName() string {
 	return "attendance"
 }
+
+// IsPresent reports whether the intern came in that day, on time or late
+func (a *Attendance) IsPresent() bool {
+	return a.Status == AttendanceHadir || a.Status == AttendanceTerlambat
+}
+
+// AttendanceRate summarises an intern's attendance over the working days of a period.
+// Rate is the share of working days present, not counting approved leave (izin).
+type AttendanceRate struct {
+	InternID    uint      `json:"intern_id"`
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	WorkingDays int       `json:"working_days"`
+	Present     int       `json:"present"` // hadir and terlambat
+	Late        int       `json:"late"`
+	Izin        int       `json:"izin"`
+	Alpha       int       `json:"alpha"`
+	Rate        float64   `json:"rate"` // 0-100
+}
+
+// AttendanceRecap is an intern's attendance over one month, as listed in the monthly recap
+type AttendanceRecap struct {
+	AttendanceRate
+	FullName string `json:"full_name"`
+	Batch    string `json:"batch"`
+	Division string `json:"division"`
+	PICID    uint   `json:"pic_id"`
+	Period   string `json:"period"` // Format: 2026-01
+}
+
+// AttendanceDay is one day of an intern's attendance calendar. Status is the attendance
+// status when there is a record, and otherwise one of the calendar day statuses.
+type AttendanceDay struct {
+	Date           time.Time  `json:"date"`
+	Status         string     `json:"status"`
+	WorkingDay     bool       `json:"working_day"`
+	HolidayName    string     `json:"holiday_name,omitempty"`
+	LeaveRequestID *uint      `json:"leave_request_id,omitempty"`
+	LeaveStatus    string     `json:"leave_status,omitempty"` // pending, approved
+	CheckInTime    *time.Time `json:"check_in_time"`
+	CheckOutTime   *time.Time `json:"check_out_time"`
+	LateMinutes    int        `json:"late_minutes"`
+	IsRemote       bool       `json:"is_remote"`
+}
+
+// AttendanceRecapFilter narrows the monthly recap. Zero values are ignored.
+type AttendanceRecapFilter struct {
+	Batch    string
+	Division string
+	PICID    uint
+}
+
+// CheckInInput is what the intern's device reports when checking in.
+// Without a kiosk token the check-in is matched against the division's office locations.
+type CheckInInput struct {
+	KioskToken string
+	ClientIP   string
+	Latitude   *float64
+	Longitude  *float64
+}
+
+// AttendanceFilter narrows attendance listings. Zero values are ignored.
+type AttendanceFilter struct {
+	InternID uint
+	PICID    uint // only interns supervised by this PIC
+	Status   string
+	IsRemote *bool
+	DateFrom *time.Time
+	DateTo   *time.Time
+}
+
+// AttendanceRepository interface
+type AttendanceRepository interface {
+	Create(attendance *Attendance) error
+	CreateMissing(records []Attendance) (int64, error)
+	GetByID(id uint) (*Attendance, error)
+	GetByInternAndDate(internID uint, date time.Time) (*Attendance, error)
+	GetAll(filter AttendanceFilter, page, limit int) ([]Attendance, int64, error)
+	GetByInternBetween(internID uint, from, to time.Time) ([]Attendance, error)
+	GetByInternsBetween(internIDs []uint, from, to time.Time) ([]Attendance, error)
+	Update(attendance *Attendance) error
+}
+
+// AttendanceUsecase interface
+type AttendanceUsecase interface {
+	CreateKioskToken(now time.Time) (string, time.Time, error)
+	CheckIn(actorID, actorRoleID uint, input CheckInInput) (*Attendance, error)
+	CheckOut(actorID, actorRoleID uint) (*Attendance, error)
+	GetToday(actorID, actorRoleID uint) (*Attendance, error)
+	GetAttendances(actorID, actorRoleID uint, filter AttendanceFilter, page, limit int) ([]Attendance, int64, error)
+	GetAttendanceRate(actorID, actorRoleID, internID uint, from, to time.Time) (*AttendanceRate, error)
+	GetCalendar(actorID, actorRoleID, internID uint, period string) ([]AttendanceDay, error)
+	GetMonthlyRecap(actorID, actorRoleID uint, period string, filter AttendanceRecapFilter) ([]AttendanceRecap, error)
+	MarkAlpha(now time.Time) error
+	MarkAlphaRange(from, to, now time.Time) (int64, error)
+}
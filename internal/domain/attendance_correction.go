@@ -0,0 +1,68 @@
+package domain
+
+import "time"
+
+// Attendance correction states
+const (
+	CorrectionPending  = "pending"
+	CorrectionApproved = "approved"
+	CorrectionRejected = "rejected"
+)
+
+// AttendanceCorrection is an intern's request to fix the attendance of a past day, e.g. a
+// forgotten check-in. The original values are kept next to the proposed ones; they are
+// captured again on approval so they show exactly what the correction replaced.
+type AttendanceCorrection struct {
+	ID                   uint       `gorm:"primaryKey" json:"id"`
+	InternID             uint       `gorm:"not null;index" json:"intern_id"`
+	Intern               User       `gorm:"foreignKey:InternID" json:"intern"`
+	Date                 time.Time  `gorm:"type:date;not null" json:"date"`
+	AttendanceID         *uint      `json:"attendance_id"`   // nil when the day had no record
+	OriginalStatus       string     `json:"original_status"` // empty when the day had no record
+	OriginalCheckInTime  *time.Time `json:"original_check_in_time"`
+	OriginalCheckOutTime *time.Time `json:"original_check_out_time"`
+	ProposedStatus       string     `gorm:"not null" json:"proposed_status"` // hadir, terlambat, izin
+	ProposedCheckInTime  *time.Time `json:"proposed_check_in_time"`          // nil for izin
+	ProposedCheckOutTime *time.Time `json:"proposed_check_out_time"`
+	Reason               string     `gorm:"not null" json:"reason"`
+	Status               string     `gorm:"not null;default:pending;index" json:"status"` // pending, approved, rejected
+	ReviewedByID         *uint      `json:"reviewed_by_id"`
+	ReviewedBy           *User      `gorm:"foreignKey:ReviewedByID" json:"reviewed_by,omitempty"`
+	ReviewedAt           *time.Time `json:"reviewed_at"`
+	RejectionReason      string     `json:"rejection_reason"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for AttendanceCorrection model
+func (AttendanceCorrection) TableName() string {
+	return "attendance_corrections"
+}
+
+// AttendanceCorrectionFilter narrows correction listings. Zero values are ignored.
+type AttendanceCorrectionFilter struct {
+	InternID uint
+	PICID    uint // only interns supervised by this PIC
+	Status   string
+}
+
+// AttendanceCorrectionRepository interface
+type AttendanceCorrectionRepository interface {
+	Create(correction *AttendanceCorrection) error
+	GetByID(id uint) (*AttendanceCorrection, error)
+	GetAll(filter AttendanceCorrectionFilter, page, limit int) ([]AttendanceCorrection, int64, error)
+	GetPendingForDay(internID uint, date time.Time) (*AttendanceCorrection, error)
+	Update(correction *AttendanceCorrection) error
+	Approve(correction *AttendanceCorrection, attendance *Attendance, audit *AuditLog) error
+	Delete(id uint) error
+}
+
+// AttendanceCorrectionUsecase interface
+type AttendanceCorrectionUsecase interface {
+	RequestCorrection(actorID, actorRoleID uint, date time.Time, status string, checkIn, checkOut *time.Time, reason string) (*AttendanceCorrection, error)
+	GetCorrections(actorID, actorRoleID uint, filter AttendanceCorrectionFilter, page, limit int) ([]AttendanceCorrection, int64, error)
+	GetCorrectionByID(actorID, actorRoleID, id uint) (*AttendanceCorrection, error)
+	ApproveCorrection(actorID, actorRoleID, id uint) (*AttendanceCorrection, error)
+	RejectCorrection(actorID, actorRoleID, id uint, reason string) (*AttendanceCorrection, error)
+	CancelCorrection(actorID, actorRoleID, id uint) error
+}
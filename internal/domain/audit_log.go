@@ -10,6 +10,7 @@ type AuditLog struct {
 	Action     string    `gorm:"not null" json:"action"` // created, updated, deleted, login
 	EntityType string    `json:"entity_type"`            // user, task, attendance, etc.
 	EntityID   *uint     `json:"entity_id"`
+	Details    string    `gorm:"type:text" json:"details"` // JSON describing the change, e.g. before and after values
 	CreatedAt  time.Time `json:"created_at"`
 }
 
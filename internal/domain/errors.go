@@ -5,4 +5,103 @@ import "errors"
 var (
 	ErrUserNotFound    = errors.New("USER_NOT_FOUND")
 	ErrInvalidPassword = errors.New("INVALID_PASSWORD")
+	ErrForbidden       = errors.New("FORBIDDEN")
+
+	// ErrInternNotFound keeps the message the intern handler already matches on
+	ErrInternNotFound = errors.New("intern profile not found")
+
+	ErrTaskNotFound          = errors.New("TASK_NOT_FOUND")
+	ErrInvalidTaskTransition = errors.New("INVALID_TASK_TRANSITION")
+	ErrReasonRequired        = errors.New("REASON_REQUIRED")
+	ErrTaskNotGradable       = errors.New("TASK_NOT_GRADABLE")
+	ErrInvalidScore          = errors.New("INVALID_SCORE")
+	ErrTaskBlocked           = errors.New("TASK_BLOCKED")
+	ErrSubtasksIncomplete    = errors.New("SUBTASKS_INCOMPLETE")
+	ErrDependencyCycle       = errors.New("DEPENDENCY_CYCLE")
+	ErrInvalidDependency     = errors.New("INVALID_DEPENDENCY")
+
+	ErrCommentNotFound = errors.New("COMMENT_NOT_FOUND")
+
+	ErrTemplateNotFound   = errors.New("TEMPLATE_NOT_FOUND")
+	ErrCohortRequired     = errors.New("COHORT_REQUIRED")
+	ErrNoMatchingInterns  = errors.New("NO_MATCHING_INTERNS")
+	ErrRecurrenceNotFound = errors.New("RECURRENCE_NOT_FOUND")
+	ErrInvalidRecurrence  = errors.New("INVALID_RECURRENCE")
+
+	ErrTimeEntryNotFound    = errors.New("TIME_ENTRY_NOT_FOUND")
+	ErrTimerAlreadyRunning  = errors.New("TIMER_ALREADY_RUNNING")
+	ErrNoRunningTimer       = errors.New("NO_RUNNING_TIMER")
+	ErrInvalidDuration      = errors.New("INVALID_DURATION")
+	ErrTimeEntryNotEditable = errors.New("TIME_ENTRY_NOT_EDITABLE")
+
+	ErrAttendanceNotFound      = errors.New("ATTENDANCE_NOT_FOUND")
+	ErrAlreadyCheckedIn        = errors.New("ALREADY_CHECKED_IN")
+	ErrNotCheckedIn            = errors.New("NOT_CHECKED_IN")
+	ErrAlreadyCheckedOut       = errors.New("ALREADY_CHECKED_OUT")
+	ErrOutsideInternshipPeriod = errors.New("OUTSIDE_INTERNSHIP_PERIOD")
+	ErrInvalidKioskToken       = errors.New("INVALID_KIOSK_TOKEN")
+	ErrInvalidPeriod           = errors.New("INVALID_PERIOD")
+	ErrOutsideOffice           = errors.New("OUTSIDE_OFFICE")
+
+	ErrCorrectionNotFound    = errors.New("CORRECTION_NOT_FOUND")
+	ErrCorrectionNotPending  = errors.New("CORRECTION_NOT_PENDING")
+	ErrCorrectionExists      = errors.New("CORRECTION_EXISTS")
+	ErrInvalidCorrection     = errors.New("INVALID_CORRECTION")
+	ErrInvalidCorrectionDate = errors.New("INVALID_CORRECTION_DATE")
+	ErrCorrectionMismatch    = errors.New("CORRECTION_MISMATCH")
+	ErrCorrectionOnLeave     = errors.New("CORRECTION_ON_LEAVE")
+
+	ErrOfficeLocationNotFound = errors.New("OFFICE_LOCATION_NOT_FOUND")
+	ErrInvalidOfficeLocation  = errors.New("INVALID_OFFICE_LOCATION")
+
+	ErrWorkScheduleNotFound = errors.New("WORK_SCHEDULE_NOT_FOUND")
+	ErrWorkScheduleExists   = errors.New("WORK_SCHEDULE_EXISTS")
+	ErrInvalidWorkSchedule  = errors.New("INVALID_WORK_SCHEDULE")
+
+	ErrHolidayNotFound     = errors.New("HOLIDAY_NOT_FOUND")
+	ErrHolidayExists       = errors.New("HOLIDAY_EXISTS")
+	ErrInvalidHolidayType  = errors.New("INVALID_HOLIDAY_TYPE")
+	ErrInvalidCalendarFile = errors.New("INVALID_CALENDAR_FILE")
+	ErrInvalidWorkingDays  = errors.New("INVALID_WORKING_DAYS")
+
+	ErrLeaveNotFound         = errors.New("LEAVE_NOT_FOUND")
+	ErrLeaveNotPending       = errors.New("LEAVE_NOT_PENDING")
+	ErrLeaveOverlap          = errors.New("LEAVE_OVERLAP")
+	ErrLeaveQuotaExceeded    = errors.New("LEAVE_QUOTA_EXCEEDED")
+	ErrInvalidLeavePeriod    = errors.New("INVALID_LEAVE_PERIOD")
+	ErrInvalidLeaveCategory  = errors.New("INVALID_LEAVE_CATEGORY")
+	ErrLeaveDocumentNotFound = errors.New("LEAVE_DOCUMENT_NOT_FOUND")
+
+	ErrMentorReviewNotFound  = errors.New("MENTOR_REVIEW_NOT_FOUND")
+	ErrMentorReviewExists    = errors.New("MENTOR_REVIEW_EXISTS")
+	ErrMentorReviewSubmitted = errors.New("MENTOR_REVIEW_SUBMITTED")
+	ErrLegacyMentorReview    = errors.New("LEGACY_MENTOR_REVIEW")
+	ErrInvalidRating         = errors.New("INVALID_RATING")
+	ErrInvalidReviewAnswers  = errors.New("INVALID_REVIEW_ANSWERS")
+	ErrRubricNotFound        = errors.New("RUBRIC_NOT_FOUND")
+	ErrInvalidRubric         = errors.New("INVALID_RUBRIC")
+	ErrRubricInUse           = errors.New("RUBRIC_IN_USE")
+	ErrReviewCycleNotFound   = errors.New("REVIEW_CYCLE_NOT_FOUND")
+	ErrReviewCycleExists     = errors.New("REVIEW_CYCLE_EXISTS")
+	ErrReviewCycleClosed     = errors.New("REVIEW_CYCLE_CLOSED")
+	ErrReopeningNotFound     = errors.New("REOPENING_NOT_FOUND")
+	ErrInvalidReviewDeadline = errors.New("INVALID_REVIEW_DEADLINE")
+
+	ErrSelfAssessmentNotFound  = errors.New("SELF_ASSESSMENT_NOT_FOUND")
+	ErrSelfAssessmentExists    = errors.New("SELF_ASSESSMENT_EXISTS")
+	ErrSelfAssessmentSubmitted = errors.New("SELF_ASSESSMENT_SUBMITTED")
+	ErrSelfAssessmentHidden    = errors.New("SELF_ASSESSMENT_HIDDEN")
+
+	ErrPeerRoundNotFound      = errors.New("PEER_ROUND_NOT_FOUND")
+	ErrPeerRoundClosed        = errors.New("PEER_ROUND_CLOSED")
+	ErrPeerRoundNotClosed     = errors.New("PEER_ROUND_NOT_CLOSED")
+	ErrInvalidPeerRound       = errors.New("INVALID_PEER_ROUND")
+	ErrNotEnoughPeers         = errors.New("NOT_ENOUGH_PEERS")
+	ErrPeerAssignmentNotFound = errors.New("PEER_ASSIGNMENT_NOT_FOUND")
+	ErrPeerFeedbackSubmitted  = errors.New("PEER_FEEDBACK_SUBMITTED")
+
+	ErrAttachmentNotFound  = errors.New("ATTACHMENT_NOT_FOUND")
+	ErrFileTooLarge        = errors.New("FILE_TOO_LARGE")
+	ErrFileTypeNotAllowed  = errors.New("FILE_TYPE_NOT_ALLOWED")
+	ErrInvalidDownloadLink = errors.New("INVALID_DOWNLOAD_LINK")
 )
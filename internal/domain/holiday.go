@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// Holiday types
+const (
+	HolidayPublic      = "public_holiday"
+	HolidayCutiBersama = "cuti_bersama" // government-designated collective leave
+)
+
+// Holiday is a non-working day on the company calendar
+type Holiday struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	Date        time.Time `gorm:"type:date;not null;uniqueIndex" json:"date"`
+	Name        string    `gorm:"not null" json:"name"`
+	Type        string    `gorm:"not null" json:"type"`                  // public_holiday, cuti_bersama
+	Source      string    `gorm:"not null;default:manual" json:"source"` // manual, ical
+	CreatedByID uint      `gorm:"not null" json:"created_by_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for Holiday model
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+// HolidayImportResult reports what an iCalendar import did
+type HolidayImportResult struct {
+	Created int64 `json:"created"`
+	Skipped int64 `json:"skipped"` // days already on the calendar
+}
+
+// HolidayRepository interface
+type HolidayRepository interface {
+	Create(holiday *Holiday) error
+	CreateMissing(holidays []Holiday) (int64, error)
+	GetByID(id uint) (*Holiday, error)
+	GetByDate(date time.Time) (*Holiday, error)
+	GetBetween(from, to time.Time) ([]Holiday, error)
+	Update(holiday *Holiday) error
+	Delete(id uint) error
+}
+
+// HolidayUsecase interface
+type HolidayUsecase interface {
+	CreateHoliday(actorID uint, date time.Time, name, holidayType string) (*Holiday, error)
+	GetHolidays(from, to time.Time) ([]Holiday, error)
+	UpdateHoliday(id uint, date time.Time, name, holidayType string) (*Holiday, error)
+	DeleteHoliday(id uint) error
+	ImportICal(actorID uint, content io.Reader, holidayType string) (*HolidayImportResult, error)
+	SuggestDeadline(internID uint, from time.Time, workingDays int) (time.Time, error)
+}
@@ -29,6 +29,8 @@ type InternRepository interface {
 	GetByID(id uint) (*InternProfile, error)
 	GetByUserID(userID uint) (*InternProfile, error)
 	GetAll(page, limit int) ([]InternProfile, int64, error)
+	FindByCohort(batch, division string, picID uint) ([]InternProfile, error)
+	GetActiveBetween(from, to time.Time) ([]InternProfile, error)
 	Update(id uint, batch, division, university, major string) (*InternProfile, error)
 }
 
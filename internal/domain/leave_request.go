@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// Leave request categories
+const (
+	LeaveSick     = "sick"
+	LeaveCampus   = "campus"
+	LeavePersonal = "personal"
+)
+
+// Leave request states
+const (
+	LeavePending  = "pending"
+	LeaveApproved = "approved"
+	LeaveRejected = "rejected"
+)
+
+// LeaveRequest is an intern's request for izin over a range of days.
+// Approved requests are written to the attendance table as izin.
+type LeaveRequest struct {
+	ID                  uint       `gorm:"primaryKey" json:"id"`
+	InternID            uint       `gorm:"not null;index" json:"intern_id"`
+	Intern              User       `gorm:"foreignKey:InternID" json:"intern"`
+	StartDate           time.Time  `gorm:"type:date;not null" json:"start_date"`
+	EndDate             time.Time  `gorm:"type:date;not null" json:"end_date"`
+	Days                int        `gorm:"not null" json:"days"`     // working days covered
+	Category            string     `gorm:"not null" json:"category"` // sick, campus, personal
+	Reason              string     `json:"reason"`
+	DocumentName        string     `json:"document_name"`
+	DocumentContentType string     `json:"document_content_type"`
+	DocumentSize        int64      `json:"document_size"`
+	DocumentKey         string     `json:"-"`
+	Status              string     `gorm:"not null;default:pending;index" json:"status"` // pending, approved, rejected
+	ReviewedByID        *uint      `json:"reviewed_by_id"`
+	ReviewedBy          *User      `gorm:"foreignKey:ReviewedByID" json:"reviewed_by,omitempty"`
+	ReviewedAt          *time.Time `json:"reviewed_at"`
+	RejectionReason     string     `json:"rejection_reason"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for LeaveRequest model
+func (LeaveRequest) TableName() string {
+	return "leave_requests"
+}
+
+// HasDocument reports whether a supporting document was uploaded
+func (l *LeaveRequest) HasDocument() bool {
+	return l.DocumentKey != ""
+}
+
+// LeaveBalance is an intern's leave allowance for the whole internship
+type LeaveBalance struct {
+	InternID  uint `json:"intern_id"`
+	Allowance int  `json:"allowance"`
+	Approved  int  `json:"approved"`
+	Pending   int  `json:"pending"`
+	Remaining int  `json:"remaining"`
+}
+
+// LeaveDocument is a supporting document uploaded with a leave request
+type LeaveDocument struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+// LeaveRequestFilter narrows leave request listings. Zero values are ignored.
+type LeaveRequestFilter struct {
+	InternID uint
+	PICID    uint // only interns supervised by this PIC
+	Status   string
+}
+
+// LeaveRequestRepository interface
+type LeaveRequestRepository interface {
+	Create(leave *LeaveRequest) error
+	GetByID(id uint) (*LeaveRequest, error)
+	GetAll(filter LeaveRequestFilter, page, limit int) ([]LeaveRequest, int64, error)
+	GetOverlapping(internID uint, from, to time.Time) ([]LeaveRequest, error)
+	SumDays(internID uint, status string) (int, error)
+	Update(leave *LeaveRequest) error
+	Approve(leave *LeaveRequest, records []Attendance) error
+	Delete(id uint) error
+}
+
+// LeaveRequestUsecase interface
+type LeaveRequestUsecase interface {
+	SubmitLeave(actorID, actorRoleID uint, startDate, endDate time.Time, category, reason string, document *LeaveDocument) (*LeaveRequest, error)
+	GetLeaveRequests(actorID, actorRoleID uint, filter LeaveRequestFilter, page, limit int) ([]LeaveRequest, int64, error)
+	GetLeaveRequestByID(actorID, actorRoleID, id uint) (*LeaveRequest, error)
+	OpenDocument(actorID, actorRoleID, id uint) (*LeaveRequest, io.ReadCloser, error)
+	ApproveLeave(actorID, actorRoleID, id uint) (*LeaveRequest, error)
+	RejectLeave(actorID, actorRoleID, id uint, reason string) (*LeaveRequest, error)
+	CancelLeave(actorID, actorRoleID, id uint) error
+	GetLeaveBalance(actorID, actorRoleID, internID uint) (*LeaveBalance, error)
+}
@@ -2,23 +2,93 @@ package domain
 
 import "time"
 
-// MentorReview represents mentor evaluations of intern potential
+// Mentor review statuses
+const (
+	ReviewDraft     = "draft"     // the PIC is still working on it
+	ReviewSubmitted = "submitted" // read-only and visible to the intern
+)
+
+// MentorReview represents mentor evaluations of intern potential.
+// There is at most one review per intern per period; interns only see submitted reviews.
+// Reviews are scored against a ReviewRubric. Reviews written before rubrics existed have no
+// RubricID and keep their ratings in the four fixed columns.
 type MentorReview struct {
-	ID              uint      `gorm:"primaryKey" json:"id"`
-	InternID        uint      `gorm:"not null" json:"intern_id"`
-	Intern          User      `gorm:"foreignKey:InternID" json:"intern"`
-	PICID           uint      `gorm:"not null" json:"pic_id"`
-	PIC             User      `gorm:"foreignKey:PICID" json:"pic"`
-	LearningAbility int       `gorm:"not null" json:"learning_ability"` // 1-5
-	Initiative      int       `gorm:"not null" json:"initiative"`       // 1-5
-	Communication   int       `gorm:"not null" json:"communication"`    // 1-5
-	ProblemSolving  int       `gorm:"not null" json:"problem_solving"`  // 1-5
-	Notes           string    `json:"notes"`
-	Period          string    `gorm:"not null" json:"period"` // Format: 2026-01
-	CreatedAt       time.Time `json:"created_at"`
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	InternID        uint           `gorm:"not null;uniqueIndex:idx_mentor_review_intern_period,priority:1" json:"intern_id"`
+	Intern          User           `gorm:"foreignKey:InternID" json:"intern"`
+	PICID           uint           `gorm:"not null;index" json:"pic_id"`
+	PIC             User           `gorm:"foreignKey:PICID" json:"pic"`
+	RubricID        *uint          `gorm:"index" json:"rubric_id"`
+	Rubric          *ReviewRubric  `gorm:"foreignKey:RubricID" json:"rubric,omitempty"`
+	Answers         []ReviewAnswer `gorm:"foreignKey:ReviewID" json:"answers"`
+	Score           float64        `json:"score"`                                      // 0-100, weighted by the rubric
+	LearningAbility int            `gorm:"not null" json:"learning_ability,omitempty"` // 1-5, legacy reviews only
+	Initiative      int            `gorm:"not null" json:"initiative,omitempty"`       // 1-5, legacy reviews only
+	Communication   int            `gorm:"not null" json:"communication,omitempty"`    // 1-5, legacy reviews only
+	ProblemSolving  int            `gorm:"not null" json:"problem_solving,omitempty"`  // 1-5, legacy reviews only
+	Notes           string         `json:"notes"`
+	Period          string         `gorm:"not null;uniqueIndex:idx_mentor_review_intern_period,priority:2" json:"period"` // Format: 2026-01
+	Status          string         `gorm:"not null;default:'draft';index" json:"status"`                                  // draft, submitted
+	SubmittedAt     *time.Time     `json:"submitted_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
 }
 
 // TableName specifies the table name for MentorReview model
 func (MentorReview) TableName() string {
 	return "mentor_reviews"
 }
+
+// IsSubmitted reports whether the PIC has submitted the review
+func (r *MentorReview) IsSubmitted() bool {
+	return r.Status == ReviewSubmitted
+}
+
+// ReviewAnswer is the score a review gives one rubric criterion
+type ReviewAnswer struct {
+	ID          uint            `gorm:"primaryKey" json:"id"`
+	ReviewID    uint            `gorm:"not null;uniqueIndex:idx_review_answer_criterion,priority:1" json:"review_id"`
+	CriterionID uint            `gorm:"not null;uniqueIndex:idx_review_answer_criterion,priority:2" json:"criterion_id"`
+	Criterion   RubricCriterion `gorm:"foreignKey:CriterionID" json:"criterion"`
+	Score       int             `gorm:"not null" json:"score"` // 1..rubric scale
+	Comment     string          `json:"comment"`
+}
+
+// TableName specifies the table name for ReviewAnswer model
+func (ReviewAnswer) TableName() string {
+	return "review_answers"
+}
+
+// ReviewAnswerInput is the score given to one criterion when writing a review
+type ReviewAnswerInput struct {
+	CriterionID uint
+	Score       int
+	Comment     string
+}
+
+// MentorReviewFilter narrows mentor review listings. Zero values are ignored.
+type MentorReviewFilter struct {
+	InternID uint
+	PICID    uint // only interns supervised by this PIC
+	Period   string
+	Status   string
+}
+
+// MentorReviewRepository interface
+type MentorReviewRepository interface {
+	Create(review *MentorReview) error
+	GetByID(id uint) (*MentorReview, error)
+	GetByInternAndPeriod(internID uint, period string) (*MentorReview, error)
+	GetAll(filter MentorReviewFilter, page, limit int) ([]MentorReview, int64, error)
+	Update(review *MentorReview) error
+	ReplaceAnswers(review *MentorReview) error
+}
+
+// MentorReviewUsecase interface
+type MentorReviewUsecase interface {
+	CreateReview(actorID, actorRoleID, internID uint, period string, answers []ReviewAnswerInput, notes string) (*MentorReview, error)
+	UpdateReview(actorID, actorRoleID, id uint, answers []ReviewAnswerInput, notes string) (*MentorReview, error)
+	SubmitReview(actorID, actorRoleID, id uint) (*MentorReview, error)
+	GetReviews(actorID, actorRoleID uint, filter MentorReviewFilter, page, limit int) ([]MentorReview, int64, error)
+	GetReviewByID(actorID, actorRoleID, id uint) (*MentorReview, error)
+}
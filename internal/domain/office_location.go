@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"math"
+	"net"
+	"strings"
+	"time"
+)
+
+// Office location enforcement modes for check-ins made outside every office of a division
+const (
+	EnforcementReject = "reject" // the check-in is refused
+	EnforcementFlag   = "flag"   // the check-in is recorded as remote
+)
+
+// earthRadiusMeters is the mean radius of the Earth used for distance calculations
+const earthRadiusMeters = 6371000
+
+// OfficeLocation is a place interns of a division may check in from, identified by its
+// office networks and/or a geofence around its coordinates. Check-ins still need a kiosk
+// token; the location is an extra check on where it was scanned. Only Hybrid locations,
+// which must name a division, let its interns check in without a kiosk token.
+// An empty Division applies to every division.
+type OfficeLocation struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	Division     string    `gorm:"not null;default:'';index" json:"division"`
+	Name         string    `gorm:"not null" json:"name"`
+	CIDRs        string    `gorm:"not null;default:''" json:"cidrs"` // comma separated, e.g. 203.0.113.0/24
+	Latitude     *float64  `json:"latitude"`
+	Longitude    *float64  `json:"longitude"`
+	RadiusMeters int       `gorm:"not null;default:0" json:"radius_meters"`
+	Enforcement  string    `gorm:"not null;default:reject" json:"enforcement"` // reject, flag
+	Hybrid       bool      `gorm:"not null;default:false" json:"hybrid"`       // check-in without kiosk token allowed
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for OfficeLocation model
+func (OfficeLocation) TableName() string {
+	return "office_locations"
+}
+
+// Networks returns the parsed office networks, skipping malformed entries
+func (l *OfficeLocation) Networks() []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(l.CIDRs, ",") {
+		if _, network, err := net.ParseCIDR(strings.TrimSpace(cidr)); err == nil {
+			networks = append(networks, network)
+		}
+	}
+	return networks
+}
+
+// HasGeofence reports whether the location defines coordinates and a radius
+func (l *OfficeLocation) HasGeofence() bool {
+	return l.Latitude != nil && l.Longitude != nil && l.RadiusMeters > 0
+}
+
+// Contains reports whether a check-in from the client IP or coordinates is at this office.
+// Either matching is enough; coordinates are only considered when both are given.
+func (l *OfficeLocation) Contains(clientIP string, latitude, longitude *float64) bool {
+	if ip := net.ParseIP(clientIP); ip != nil {
+		for _, network := range l.Networks() {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	if l.HasGeofence() && latitude != nil && longitude != nil {
+		return DistanceMeters(*l.Latitude, *l.Longitude, *latitude, *longitude) <= float64(l.RadiusMeters)
+	}
+	return false
+}
+
+// DistanceMeters returns the great-circle distance between two coordinates
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// OfficeLocationRepository interface
+type OfficeLocationRepository interface {
+	Create(location *OfficeLocation) error
+	GetByID(id uint) (*OfficeLocation, error)
+	GetAll() ([]OfficeLocation, error)
+	GetForDivision(division string) ([]OfficeLocation, error)
+	Update(location *OfficeLocation) error
+	Delete(id uint) error
+}
+
+// OfficeLocationUsecase interface
+type OfficeLocationUsecase interface {
+	CreateLocation(division, name string, cidrs []string, latitude, longitude *float64, radiusMeters int, enforcement string, hybrid bool) (*OfficeLocation, error)
+	GetLocations() ([]OfficeLocation, error)
+	GetLocationByID(id uint) (*OfficeLocation, error)
+	UpdateLocation(id uint, division, name string, cidrs []string, latitude, longitude *float64, radiusMeters int, enforcement string, hybrid bool) (*OfficeLocation, error)
+	DeleteLocation(id uint) error
+}
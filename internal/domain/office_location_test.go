@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceMeters(t *testing.T) {
+	tests := []struct {
+		name       string
+		lat1, lng1 float64
+		lat2, lng2 float64
+		want       float64
+		tolerance  float64
+	}{
+		{"same point", -6.1754, 106.8272, -6.1754, 106.8272, 0, 0.001},
+		{"one degree of latitude", 0, 0, 1, 0, 111195, 1},
+		{"one degree of longitude at the equator", 0, 0, 0, 1, 111195, 1},
+		{"across the antimeridian", 0, 179.5, 0, -179.5, 111195, 1},
+		{"monas to gedung sate", -6.1754, 106.8272, -6.9025, 107.6187, 119100, 500},
+		{"antipodes", 0, 0, 0, 180, math.Pi * earthRadiusMeters, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DistanceMeters(tt.lat1, tt.lng1, tt.lat2, tt.lng2)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("DistanceMeters() = %.1f, want %.1f ± %.1f", got, tt.want, tt.tolerance)
+			}
+			if reverse := DistanceMeters(tt.lat2, tt.lng2, tt.lat1, tt.lng1); math.Abs(reverse-got) > 0.001 {
+				t.Errorf("DistanceMeters() is not symmetric: %.3f and %.3f", got, reverse)
+			}
+		})
+	}
+}
+
+func TestOfficeLocationContains(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	// 0.0009° of longitude is about 100 m at this latitude, 0.01° of latitude about 1.1 km
+	office := OfficeLocation{
+		CIDRs:        "203.0.113.0/24, not-a-cidr, 2001:db8::/32",
+		Latitude:     ptr(-6.2000),
+		Longitude:    ptr(106.8166),
+		RadiusMeters: 150,
+	}
+	networkOnly := OfficeLocation{CIDRs: "198.51.100.0/24"}
+	noRadius := OfficeLocation{Latitude: ptr(-6.2000), Longitude: ptr(106.8166)}
+
+	tests := []struct {
+		name      string
+		location  OfficeLocation
+		clientIP  string
+		latitude  *float64
+		longitude *float64
+		want      bool
+	}{
+		{"office network", office, "203.0.113.42", nil, nil, true},
+		{"office IPv6 network", office, "2001:db8::1", nil, nil, true},
+		{"other network without coordinates", office, "192.0.2.1", nil, nil, false},
+		{"invalid client IP", office, "unknown", nil, nil, false},
+		{"inside the geofence", office, "192.0.2.1", ptr(-6.2000), ptr(106.8175), true},
+		{"outside the geofence", office, "192.0.2.1", ptr(-6.2100), ptr(106.8166), false},
+		{"outside the geofence on the office network", office, "203.0.113.42", ptr(-6.2100), ptr(106.8166), true},
+		{"only latitude given", office, "192.0.2.1", ptr(-6.2000), nil, false},
+		{"network-only location ignores coordinates", networkOnly, "192.0.2.1", ptr(-6.2000), ptr(106.8166), false},
+		{"geofence without radius", noRadius, "192.0.2.1", ptr(-6.2000), ptr(106.8166), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.location.Contains(tt.clientIP, tt.latitude, tt.longitude); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
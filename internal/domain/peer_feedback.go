@@ -0,0 +1,138 @@
+package domain
+
+import "time"
+
+// PeerFeedbackRound is an HR-configured round in which interns of one batch and division
+// give anonymous feedback to the peers they are assigned, scored against a rubric.
+// Results are only published once HR closes the round, and only for interns with at
+// least MinRespondents responses.
+type PeerFeedbackRound struct {
+	ID                 uint          `gorm:"primaryKey" json:"id"`
+	Name               string        `gorm:"not null" json:"name"`
+	Batch              string        `gorm:"not null;index" json:"batch"`
+	Division           string        `gorm:"not null" json:"division"`
+	Period             string        `gorm:"not null;index" json:"period"` // Format: 2026-01
+	RubricID           uint          `gorm:"not null" json:"rubric_id"`
+	Rubric             *ReviewRubric `gorm:"foreignKey:RubricID" json:"rubric,omitempty"`
+	Deadline           time.Time     `gorm:"not null" json:"deadline"`
+	PeersPerIntern     int           `gorm:"not null" json:"peers_per_intern"` // how many peers each intern gives feedback to
+	MinRespondents     int           `gorm:"not null" json:"min_respondents"`
+	IncludeInPotential bool          `gorm:"not null;default:false" json:"include_in_potential"` // closing writes the peer score to PotentialScore
+	ClosedAt           *time.Time    `json:"closed_at"`
+	CreatedByID        uint          `gorm:"not null" json:"created_by_id"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+}
+
+// TableName specifies the table name for PeerFeedbackRound model
+func (PeerFeedbackRound) TableName() string {
+	return "peer_feedback_rounds"
+}
+
+// IsOpen reports whether feedback can still be given at the given time
+func (r *PeerFeedbackRound) IsOpen(at time.Time) bool {
+	return r.ClosedAt == nil && !at.After(r.Deadline)
+}
+
+// PeerFeedbackAssignment asks a reviewer to give feedback to one peer. It only records
+// whether the feedback was given; the feedback itself is stored without the reviewer.
+type PeerFeedbackAssignment struct {
+	ID          uint               `gorm:"primaryKey" json:"id"`
+	RoundID     uint               `gorm:"not null;uniqueIndex:idx_peer_assignment_pair,priority:1" json:"round_id"`
+	Round       *PeerFeedbackRound `gorm:"foreignKey:RoundID" json:"round,omitempty"`
+	ReviewerID  uint               `gorm:"not null;uniqueIndex:idx_peer_assignment_pair,priority:2;index" json:"reviewer_id"`
+	RevieweeID  uint               `gorm:"not null;uniqueIndex:idx_peer_assignment_pair,priority:3" json:"reviewee_id"`
+	Reviewee    User               `gorm:"foreignKey:RevieweeID" json:"reviewee"`
+	SubmittedAt *time.Time         `json:"submitted_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+// TableName specifies the table name for PeerFeedbackAssignment model
+func (PeerFeedbackAssignment) TableName() string {
+	return "peer_feedback_assignments"
+}
+
+// PeerFeedback is one anonymous response about an intern
+type PeerFeedback struct {
+	ID         uint                 `gorm:"primaryKey" json:"id"`
+	RoundID    uint                 `gorm:"not null;index:idx_peer_feedback_reviewee,priority:1" json:"round_id"`
+	RevieweeID uint                 `gorm:"not null;index:idx_peer_feedback_reviewee,priority:2" json:"reviewee_id"`
+	Answers    []PeerFeedbackAnswer `gorm:"foreignKey:FeedbackID" json:"answers"`
+	Score      float64              `json:"score"` // 0-100, weighted by the rubric
+	Comment    string               `gorm:"type:text" json:"comment"`
+}
+
+// TableName specifies the table name for PeerFeedback model
+func (PeerFeedback) TableName() string {
+	return "peer_feedback"
+}
+
+// PeerFeedbackAnswer is the score a response gives one rubric criterion
+type PeerFeedbackAnswer struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	FeedbackID  uint   `gorm:"not null;index" json:"feedback_id"`
+	CriterionID uint   `gorm:"not null" json:"criterion_id"`
+	Score       int    `gorm:"not null" json:"score"` // 1..rubric scale
+	Comment     string `json:"comment"`
+}
+
+// TableName specifies the table name for PeerFeedbackAnswer model
+func (PeerFeedbackAnswer) TableName() string {
+	return "peer_feedback_answers"
+}
+
+// PeerFeedbackResult is the aggregated peer feedback of an intern in a closed round.
+// Scores and comments are left out while Respondents is below the round's threshold.
+type PeerFeedbackResult struct {
+	InternID    uint                  `json:"intern_id"`
+	FullName    string                `json:"full_name"`
+	Respondents int                   `json:"respondents"`
+	Visible     bool                  `json:"visible"`
+	Score       *float64              `json:"score"` // 0-100
+	Criteria    []PeerCriterionResult `json:"criteria"`
+	Comments    []string              `json:"comments"`
+}
+
+// PeerCriterionResult is the average peer score of one rubric criterion
+type PeerCriterionResult struct {
+	CriterionID  uint    `json:"criterion_id"`
+	Name         string  `json:"name"`
+	AverageScore float64 `json:"average_score"`
+}
+
+// PeerFeedbackRoundInput configures a new peer feedback round.
+// A zero RubricID uses the rubric that applies to the division in the period.
+type PeerFeedbackRoundInput struct {
+	Name               string
+	Batch              string
+	Division           string
+	Period             string
+	RubricID           uint
+	Deadline           time.Time
+	PeersPerIntern     int
+	MinRespondents     int
+	IncludeInPotential bool
+}
+
+// PeerFeedbackRepository interface
+type PeerFeedbackRepository interface {
+	CreateRound(round *PeerFeedbackRound, assignments []PeerFeedbackAssignment) error
+	GetRoundByID(id uint) (*PeerFeedbackRound, error)
+	GetRounds(page, limit int) ([]PeerFeedbackRound, int64, error)
+	CloseRound(round *PeerFeedbackRound, scores []PotentialScore) error
+	GetAssignmentByID(id uint) (*PeerFeedbackAssignment, error)
+	GetAssignments(reviewerID uint, pendingOnly bool) ([]PeerFeedbackAssignment, error)
+	SubmitFeedback(assignment *PeerFeedbackAssignment, feedback *PeerFeedback) error
+	GetFeedback(roundID uint) ([]PeerFeedback, error)
+}
+
+// PeerFeedbackUsecase interface
+type PeerFeedbackUsecase interface {
+	CreateRound(actorID uint, input PeerFeedbackRoundInput) (*PeerFeedbackRound, error)
+	GetRounds(page, limit int) ([]PeerFeedbackRound, int64, error)
+	GetRoundByID(id uint) (*PeerFeedbackRound, error)
+	CloseRound(id uint) (*PeerFeedbackRound, error)
+	GetAssignments(actorID, actorRoleID uint, pendingOnly bool) ([]PeerFeedbackAssignment, error)
+	SubmitFeedback(actorID, actorRoleID, assignmentID uint, answers []ReviewAnswerInput, comment string) error
+	GetResults(actorID, actorRoleID, roundID uint) ([]PeerFeedbackResult, error)
+}
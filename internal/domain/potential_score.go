@@ -2,13 +2,14 @@ package domain
 
 import "time"
 
-// PotentialScore represents calculated potential metrics from mentor reviews
+// PotentialScore represents calculated potential metrics from mentor reviews and peer feedback
 type PotentialScore struct {
 	ID             uint      `gorm:"primaryKey" json:"id"`
-	InternID       uint      `gorm:"not null" json:"intern_id"`
+	InternID       uint      `gorm:"not null;uniqueIndex:idx_potential_score_intern_period,priority:1" json:"intern_id"`
 	Intern         User      `gorm:"foreignKey:InternID" json:"intern"`
-	Period         string    `gorm:"not null" json:"period"` // Format: 2026-01
-	MentorAvgScore float64   `json:"mentor_avg_score"`       // 0-100
+	Period         string    `gorm:"not null;uniqueIndex:idx_potential_score_intern_period,priority:2" json:"period"` // Format: 2026-01
+	MentorAvgScore *float64  `json:"mentor_avg_score"`                                                                // 0-100, nil until calculated from mentor reviews
+	PeerScore      *float64  `json:"peer_score"`                                                                      // 0-100, from peer feedback rounds that feed potential
 	CreatedAt      time.Time `json:"created_at"`
 }
 
@@ -0,0 +1,68 @@
+package domain
+
+import "time"
+
+// ReviewCycle is the window in which PICs write mentor reviews for a period.
+// The cycle closes at its deadline or when HR closes it early; after that reviews of the
+// period are read-only unless HR reopens them for a specific intern.
+type ReviewCycle struct {
+	ID         uint              `gorm:"primaryKey" json:"id"`
+	Period     string            `gorm:"not null;uniqueIndex" json:"period"` // Format: 2026-01
+	Deadline   time.Time         `gorm:"not null" json:"deadline"`
+	ClosedAt   *time.Time        `json:"closed_at"` // set when HR closes the cycle before its deadline
+	OpenedByID uint              `gorm:"not null" json:"opened_by_id"`
+	OpenedBy   User              `gorm:"foreignKey:OpenedByID" json:"opened_by"`
+	Reopenings []ReviewReopening `gorm:"foreignKey:CycleID" json:"reopenings,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// TableName specifies the table name for ReviewCycle model
+func (ReviewCycle) TableName() string {
+	return "review_cycles"
+}
+
+// IsOpen reports whether reviews of the period can still be written at the given time
+func (c *ReviewCycle) IsOpen(at time.Time) bool {
+	return c.ClosedAt == nil && !at.After(c.Deadline)
+}
+
+// ReviewReopening lets the PIC of one intern write or change the intern's review
+// after the cycle closed, until its own deadline
+type ReviewReopening struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	CycleID      uint      `gorm:"not null;uniqueIndex:idx_review_reopening_intern,priority:1" json:"cycle_id"`
+	InternID     uint      `gorm:"not null;uniqueIndex:idx_review_reopening_intern,priority:2" json:"intern_id"`
+	Intern       User      `gorm:"foreignKey:InternID" json:"intern"`
+	Deadline     time.Time `gorm:"not null" json:"deadline"`
+	Reason       string    `gorm:"type:text" json:"reason"`
+	ReopenedByID uint      `gorm:"not null" json:"reopened_by_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for ReviewReopening model
+func (ReviewReopening) TableName() string {
+	return "review_reopenings"
+}
+
+// ReviewCycleRepository interface
+type ReviewCycleRepository interface {
+	Create(cycle *ReviewCycle) error
+	GetByID(id uint) (*ReviewCycle, error)
+	GetByPeriod(period string) (*ReviewCycle, error)
+	GetAll(page, limit int) ([]ReviewCycle, int64, error)
+	Update(cycle *ReviewCycle) error
+	GetReopening(cycleID, internID uint) (*ReviewReopening, error)
+	Reopen(reopening *ReviewReopening, period string) error
+}
+
+// ReviewCycleUsecase interface
+type ReviewCycleUsecase interface {
+	OpenCycle(actorID uint, period string, deadline time.Time) (*ReviewCycle, error)
+	GetCycles(page, limit int) ([]ReviewCycle, int64, error)
+	GetCycleByID(id uint) (*ReviewCycle, error)
+	UpdateDeadline(id uint, deadline time.Time) (*ReviewCycle, error)
+	CloseCycle(id uint) (*ReviewCycle, error)
+	ReopenForIntern(actorID, id, internID uint, deadline time.Time, reason string) (*ReviewReopening, error)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// ReviewRubric is a versioned set of criteria mentor reviews are scored against.
+// Rubrics are never changed once created; HR publishes a new version instead, so reviews
+// stay readable under the version they used. An empty Division applies to every division
+// and an empty EffectivePeriod applies from the start.
+type ReviewRubric struct {
+	ID              uint              `gorm:"primaryKey" json:"id"`
+	Name            string            `gorm:"not null" json:"name"`
+	Division        string            `gorm:"not null;default:'';uniqueIndex:idx_review_rubric_version,priority:1" json:"division"`
+	Version         int               `gorm:"not null;uniqueIndex:idx_review_rubric_version,priority:2" json:"version"`
+	EffectivePeriod string            `gorm:"not null;default:''" json:"effective_period"` // Format: 2026-01, first period the version applies to
+	Scale           int               `gorm:"not null" json:"scale"`                       // answers are scored 1..Scale
+	Criteria        []RubricCriterion `gorm:"foreignKey:RubricID" json:"criteria"`
+	CreatedByID     *uint             `json:"created_by_id"` // nil for the built-in default
+	CreatedAt       time.Time         `json:"created_at"`
+}
+
+// TableName specifies the table name for ReviewRubric model
+func (ReviewRubric) TableName() string {
+	return "review_rubrics"
+}
+
+// RubricCriterion is one scored criterion of a rubric
+type RubricCriterion struct {
+	ID          uint    `gorm:"primaryKey" json:"id"`
+	RubricID    uint    `gorm:"not null;index" json:"rubric_id"`
+	Name        string  `gorm:"not null" json:"name"`
+	Description string  `json:"description"`
+	Weight      float64 `gorm:"not null" json:"weight"` // relative to the other criteria
+	Position    int     `gorm:"not null" json:"position"`
+}
+
+// TableName specifies the table name for RubricCriterion model
+func (RubricCriterion) TableName() string {
+	return "rubric_criteria"
+}
+
+// Score returns the weighted score of answers keyed by criterion ID on a 0-100 scale.
+// Criteria without an answer add nothing to the score.
+func (r *ReviewRubric) Score(scores map[uint]int) float64 {
+	var total, weights float64
+	for _, criterion := range r.Criteria {
+		weights += criterion.Weight
+		if score, ok := scores[criterion.ID]; ok {
+			total += criterion.Weight * float64(score) / float64(r.Scale)
+		}
+	}
+	if weights == 0 {
+		return 0
+	}
+	return total / weights * 100
+}
+
+// RubricCriterionInput is a criterion of a rubric being created
+type RubricCriterionInput struct {
+	Name        string
+	Description string
+	Weight      float64
+}
+
+// ReviewRubricRepository interface
+type ReviewRubricRepository interface {
+	Create(rubric *ReviewRubric) error
+	GetByID(id uint) (*ReviewRubric, error)
+	GetAll(division string) ([]ReviewRubric, error)
+	FindFor(division, period string) (*ReviewRubric, error)
+	LatestVersion(division string) (int, error)
+	IsUsed(id uint) (bool, error)
+	Delete(id uint) error
+}
+
+// ReviewRubricUsecase interface
+type ReviewRubricUsecase interface {
+	CreateRubric(actorID uint, name, division, effectivePeriod string, scale int, criteria []RubricCriterionInput) (*ReviewRubric, error)
+	GetRubrics(division string) ([]ReviewRubric, error)
+	GetRubricByID(id uint) (*ReviewRubric, error)
+	GetRubricForIntern(actorID, actorRoleID, internID uint, period string) (*ReviewRubric, error)
+	DeleteRubric(id uint) error
+}
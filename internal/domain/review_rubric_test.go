@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReviewRubricScore(t *testing.T) {
+	rubric := ReviewRubric{
+		Scale: 5,
+		Criteria: []RubricCriterion{
+			{ID: 1, Name: "Technical skill", Weight: 2},
+			{ID: 2, Name: "Communication", Weight: 1},
+			{ID: 3, Name: "Initiative", Weight: 1},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		rubric ReviewRubric
+		scores map[uint]int
+		want   float64
+	}{
+		{"all top scores", rubric, map[uint]int{1: 5, 2: 5, 3: 5}, 100},
+		{"all lowest scores", rubric, map[uint]int{1: 1, 2: 1, 3: 1}, 20},
+		{"weighted", rubric, map[uint]int{1: 5, 2: 1, 3: 3}, 70},
+		{"heavier criterion counts more", rubric, map[uint]int{1: 1, 2: 5, 3: 5}, 60},
+		{"missing answer adds nothing", rubric, map[uint]int{1: 5, 2: 5}, 75},
+		{"unknown criterion is ignored", rubric, map[uint]int{1: 5, 2: 5, 3: 5, 9: 1}, 100},
+		{"no answers", rubric, map[uint]int{}, 0},
+		{"ten-point scale", ReviewRubric{Scale: 10, Criteria: rubric.Criteria}, map[uint]int{1: 8, 2: 6, 3: 10}, 80},
+		{"no criteria", ReviewRubric{Scale: 5}, map[uint]int{1: 5}, 0},
+		{"zero weights", ReviewRubric{Scale: 5, Criteria: []RubricCriterion{{ID: 1}}}, map[uint]int{1: 5}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rubric.Score(tt.scores); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,14 @@ package domain
 
 import "time"
 
+// Role IDs as seeded by database.SeedRoles
+const (
+	RoleSuperAdmin uint = 1
+	RoleHR         uint = 2
+	RolePIC        uint = 3
+	RoleIntern     uint = 4
+)
+
 // Role represents user role types in the system
 type Role struct {
 	ID          uint      `gorm:"primaryKey" json:"id"`
@@ -14,3 +22,8 @@ type Role struct {
 func (Role) TableName() string {
 	return "roles"
 }
+
+// IsHROrAbove reports whether the role has organisation-wide access
+func IsHROrAbove(roleID uint) bool {
+	return roleID == RoleSuperAdmin || roleID == RoleHR
+}
@@ -0,0 +1,96 @@
+package domain
+
+import "time"
+
+// SelfAssessment is an intern's rating of themselves for a period, on the same rubric
+// as the mentor review. It uses the review statuses: drafts stay private to the intern,
+// and the PIC only sees a submitted self-assessment after submitting their own review.
+type SelfAssessment struct {
+	ID          uint                   `gorm:"primaryKey" json:"id"`
+	InternID    uint                   `gorm:"not null;uniqueIndex:idx_self_assessment_intern_period,priority:1" json:"intern_id"`
+	Intern      User                   `gorm:"foreignKey:InternID" json:"intern"`
+	RubricID    uint                   `gorm:"not null;index" json:"rubric_id"`
+	Rubric      *ReviewRubric          `gorm:"foreignKey:RubricID" json:"rubric,omitempty"`
+	Answers     []SelfAssessmentAnswer `gorm:"foreignKey:AssessmentID" json:"answers"`
+	Score       float64                `json:"score"` // 0-100, weighted by the rubric
+	Notes       string                 `json:"notes"`
+	Period      string                 `gorm:"not null;uniqueIndex:idx_self_assessment_intern_period,priority:2" json:"period"` // Format: 2026-01
+	Status      string                 `gorm:"not null;default:'draft';index" json:"status"`                                    // draft, submitted
+	SubmittedAt *time.Time             `json:"submitted_at"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+// TableName specifies the table name for SelfAssessment model
+func (SelfAssessment) TableName() string {
+	return "self_assessments"
+}
+
+// IsSubmitted reports whether the intern has submitted the self-assessment
+func (a *SelfAssessment) IsSubmitted() bool {
+	return a.Status == ReviewSubmitted
+}
+
+// SelfAssessmentAnswer is the score an intern gives themselves on one rubric criterion
+type SelfAssessmentAnswer struct {
+	ID           uint            `gorm:"primaryKey" json:"id"`
+	AssessmentID uint            `gorm:"not null;uniqueIndex:idx_self_assessment_answer_criterion,priority:1" json:"assessment_id"`
+	CriterionID  uint            `gorm:"not null;uniqueIndex:idx_self_assessment_answer_criterion,priority:2" json:"criterion_id"`
+	Criterion    RubricCriterion `gorm:"foreignKey:CriterionID" json:"criterion"`
+	Score        int             `gorm:"not null" json:"score"` // 1..rubric scale
+	Comment      string          `json:"comment"`
+}
+
+// TableName specifies the table name for SelfAssessmentAnswer model
+func (SelfAssessmentAnswer) TableName() string {
+	return "self_assessment_answers"
+}
+
+// ReviewComparison puts a mentor review next to the intern's self-assessment of the same period
+type ReviewComparison struct {
+	Review         *MentorReview         `json:"review"`
+	SelfAssessment *SelfAssessment       `json:"self_assessment"` // nil until the intern submits one
+	Criteria       []CriterionComparison `json:"criteria"`
+	ScoreGap       *float64              `json:"score_gap"` // mentor score minus self score, 0-100 scale
+}
+
+// CriterionComparison compares the mentor and self scores of one criterion.
+// Gap is the mentor score minus the self score; Highlighted marks gaps large enough to discuss.
+type CriterionComparison struct {
+	CriterionID uint    `json:"criterion_id"`
+	Name        string  `json:"name"`
+	Weight      float64 `json:"weight"`
+	MentorScore *int    `json:"mentor_score"`
+	SelfScore   *int    `json:"self_score"`
+	Gap         *int    `json:"gap"`
+	Highlighted bool    `json:"highlighted"`
+}
+
+// SelfAssessmentFilter narrows self-assessment listings. Zero values are ignored.
+type SelfAssessmentFilter struct {
+	InternID       uint
+	PICID          uint // only interns supervised by this PIC
+	Period         string
+	Status         string
+	MentorReviewed bool // only periods whose mentor review has been submitted
+}
+
+// SelfAssessmentRepository interface
+type SelfAssessmentRepository interface {
+	Create(assessment *SelfAssessment) error
+	GetByID(id uint) (*SelfAssessment, error)
+	GetByInternAndPeriod(internID uint, period string) (*SelfAssessment, error)
+	GetAll(filter SelfAssessmentFilter, page, limit int) ([]SelfAssessment, int64, error)
+	Update(assessment *SelfAssessment) error
+	ReplaceAnswers(assessment *SelfAssessment) error
+}
+
+// SelfAssessmentUsecase interface
+type SelfAssessmentUsecase interface {
+	CreateAssessment(actorID, actorRoleID uint, period string, answers []ReviewAnswerInput, notes string) (*SelfAssessment, error)
+	UpdateAssessment(actorID, actorRoleID, id uint, answers []ReviewAnswerInput, notes string) (*SelfAssessment, error)
+	SubmitAssessment(actorID, actorRoleID, id uint) (*SelfAssessment, error)
+	GetAssessments(actorID, actorRoleID uint, filter SelfAssessmentFilter, page, limit int) ([]SelfAssessment, int64, error)
+	GetAssessmentByID(actorID, actorRoleID, id uint) (*SelfAssessment, error)
+	CompareWithReview(actorID, actorRoleID, reviewID uint) (*ReviewComparison, error)
+}
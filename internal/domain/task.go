@@ -2,21 +2,101 @@ package domain
 
 import "time"
 
+// Task statuses. Interns move todo -> in_progress -> submitted,
+// the PIC then accepts (done) or sends the task back to in_progress.
+const (
+	TaskStatusTodo       = "todo"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusSubmitted  = "submitted"
+	TaskStatusDone       = "done"
+)
+
 // Task represents work assignments for interns
 type Task struct {
-	ID           uint       `gorm:"primaryKey" json:"id"`
-	InternID     uint       `gorm:"not null" json:"intern_id"`
-	Intern       User       `gorm:"foreignKey:InternID" json:"intern"`
-	Title        string     `gorm:"not null" json:"title"`
-	Description  string     `json:"description"`
-	Status       string     `gorm:"default:todo" json:"status"` // todo, in_progress, done
-	QualityScore *int       `json:"quality_score"`              // 0-100
-	Deadline     time.Time  `json:"deadline"`
-	CompletedAt  *time.Time `json:"completed_at"`
-	CreatedAt    time.Time  `json:"created_at"`
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	InternID        uint       `gorm:"not null;index;uniqueIndex:idx_task_occurrence,priority:2" json:"intern_id"`
+	Intern          User       `gorm:"foreignKey:InternID" json:"intern"`
+	AssignedByID    *uint      `json:"assigned_by_id"`
+	AssignedBy      *User      `gorm:"foreignKey:AssignedByID" json:"assigned_by,omitempty"`
+	ParentID        *uint      `gorm:"index" json:"parent_id"`
+	Subtasks        []Task     `gorm:"foreignKey:ParentID" json:"subtasks,omitempty"`
+	TemplateID      *uint      `gorm:"index" json:"template_id"`
+	RecurrenceID    *uint      `gorm:"uniqueIndex:idx_task_occurrence,priority:1" json:"recurrence_id"`
+	OccurrenceDate  *time.Time `gorm:"type:date;uniqueIndex:idx_task_occurrence,priority:3" json:"occurrence_date"`
+	Title           string     `gorm:"not null" json:"title"`
+	Description     string     `json:"description"`
+	Status          string     `gorm:"default:todo" json:"status"` // todo, in_progress, submitted, done
+	QualityScore    *int       `json:"quality_score"`              // 0-100
+	Feedback        string     `json:"feedback"`
+	GradedByID      *uint      `json:"graded_by_id"`
+	GradedAt        *time.Time `json:"graded_at"`
+	Deadline        time.Time  `json:"deadline"`
+	SubmittedAt     *time.Time `json:"submitted_at"` // last time the intern submitted the task
+	CompletedAt     *time.Time `json:"completed_at"`
+	LatenessMinutes int        `gorm:"not null;default:0" json:"lateness_minutes"` // submitted past the deadline
+	IsOverdue       bool       `gorm:"-" json:"is_overdue"`                        // computed, not stored
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 // TableName specifies the table name for Task model
 func (Task) TableName() string {
 	return "tasks"
 }
+
+// OverdueAt reports whether the task is past its deadline without having been handed in
+func (t *Task) OverdueAt(now time.Time) bool {
+	if t.Status != TaskStatusTodo && t.Status != TaskStatusInProgress {
+		return false
+	}
+	return now.After(t.Deadline)
+}
+
+// TaskFilter narrows task listings. Zero values mean "no filter".
+type TaskFilter struct {
+	InternID     uint
+	PICID        uint // only tasks of interns mentored by this PIC
+	Status       string
+	DeadlineFrom *time.Time
+	DeadlineTo   *time.Time
+	OverdueAt    *time.Time // only tasks overdue at this moment
+}
+
+// TaskRepository interface
+type TaskRepository interface {
+	Create(task *Task) error
+	CreateBatch(tasks []Task) error
+	CreateOccurrences(tasks []Task) (int64, error)
+	GetByID(id uint) (*Task, error)
+	GetAll(filter TaskFilter, page, limit int) ([]Task, int64, error)
+	Update(task *Task) error
+	Delete(id uint) ([]string, error) // returns the storage keys of the deleted attachments
+	UpdateStatus(task *Task, history *TaskStatusHistory) error
+	GetStatusHistory(taskID uint) ([]TaskStatusHistory, error)
+	SaveGrade(task *Task, grade *TaskGrade) error
+	GetGrades(taskID uint) ([]TaskGrade, error)
+	GetSubtasks(parentID uint) ([]Task, error)
+	GetBlockers(taskID uint) ([]Task, error)
+	GetBlocking(taskID uint) ([]Task, error)
+	AddDependency(dependency *TaskDependency) error
+	RemoveDependency(taskID, blockedByID uint) error
+}
+
+// TaskUsecase interface
+type TaskUsecase interface {
+	CreateTask(actorID, actorRoleID, internID uint, title, description string, deadline time.Time) (*Task, error)
+	GetTaskByID(actorID, actorRoleID, id uint) (*Task, error)
+	GetAllTasks(actorID, actorRoleID uint, filter TaskFilter, page, limit int) ([]Task, int64, error)
+	UpdateTask(actorID, actorRoleID, id uint, title, description string, deadline time.Time) (*Task, error)
+	DeleteTask(actorID, actorRoleID, id uint) error
+	ChangeStatus(actorID, actorRoleID, id uint, status, reason string) (*Task, error)
+	GetStatusHistory(actorID, actorRoleID, id uint) ([]TaskStatusHistory, error)
+	GradeTask(actorID, actorRoleID, id uint, score int, feedback string) (*Task, error)
+	GetGrades(actorID, actorRoleID, id uint) ([]TaskGrade, error)
+	GetOverdueTasks(actorID, actorRoleID uint, filter TaskFilter, page, limit int) ([]Task, int64, error)
+	CreateSubtask(actorID, actorRoleID, parentID uint, title, description string, deadline time.Time) (*Task, error)
+	GetSubtasks(actorID, actorRoleID, parentID uint) ([]Task, error)
+	AddDependency(actorID, actorRoleID, taskID, blockedByID uint) error
+	RemoveDependency(actorID, actorRoleID, taskID, blockedByID uint) error
+	GetDependencies(actorID, actorRoleID, taskID uint) (blockedBy []Task, blocking []Task, err error)
+}
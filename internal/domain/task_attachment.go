@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// TaskAttachment is a file (deliverable, screenshot, archive) uploaded against a task
+type TaskAttachment struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	TaskID      uint      `gorm:"not null;index" json:"task_id"`
+	UploaderID  uint      `gorm:"not null" json:"uploader_id"`
+	Uploader    User      `gorm:"foreignKey:UploaderID" json:"uploader"`
+	FileName    string    `gorm:"not null" json:"file_name"`
+	ContentType string    `gorm:"not null" json:"content_type"`
+	Size        int64     `gorm:"not null" json:"size"`
+	Checksum    string    `gorm:"not null" json:"checksum"` // SHA-256, hex encoded
+	StorageKey  string    `gorm:"not null;unique" json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TaskAttachment model
+func (TaskAttachment) TableName() string {
+	return "task_attachments"
+}
+
+// TaskAttachmentRepository interface
+type TaskAttachmentRepository interface {
+	Create(attachment *TaskAttachment) error
+	GetByID(id uint) (*TaskAttachment, error)
+	GetByTaskID(taskID uint) ([]TaskAttachment, error)
+	Delete(id uint) error
+}
+
+// TaskAttachmentUsecase interface
+type TaskAttachmentUsecase interface {
+	Upload(actorID, actorRoleID, taskID uint, fileName string, size int64, content io.Reader) (*TaskAttachment, error)
+	GetAttachments(actorID, actorRoleID, taskID uint) ([]TaskAttachment, error)
+	CreateDownloadToken(actorID, actorRoleID, taskID, attachmentID uint) (string, time.Time, error)
+	OpenDownload(attachmentID uint, token string) (*TaskAttachment, io.ReadCloser, error)
+	DeleteAttachment(actorID, actorRoleID, taskID, attachmentID uint) error
+}
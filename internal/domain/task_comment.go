@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// TaskComment is a message in the discussion thread of a task
+type TaskComment struct {
+	ID        uint                 `gorm:"primaryKey" json:"id"`
+	TaskID    uint                 `gorm:"not null;index" json:"task_id"`
+	AuthorID  uint                 `gorm:"not null" json:"author_id"`
+	Author    User                 `gorm:"foreignKey:AuthorID" json:"author"`
+	Body      string               `gorm:"type:text;not null" json:"body"`
+	Mentions  []TaskCommentMention `gorm:"foreignKey:CommentID" json:"mentions"`
+	EditedAt  *time.Time           `json:"edited_at"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// TableName specifies the table name for TaskComment model
+func (TaskComment) TableName() string {
+	return "task_comments"
+}
+
+// TaskCommentMention links a comment to a user mentioned with @username
+type TaskCommentMention struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	CommentID uint      `gorm:"not null;uniqueIndex:idx_comment_mention" json:"comment_id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_comment_mention;index" json:"user_id"`
+	User      User      `gorm:"foreignKey:UserID" json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TaskCommentMention model
+func (TaskCommentMention) TableName() string {
+	return "task_comment_mentions"
+}
+
+// TaskCommentRepository interface
+type TaskCommentRepository interface {
+	Create(comment *TaskComment) error
+	GetByID(id uint) (*TaskComment, error)
+	GetByTaskID(taskID uint) ([]TaskComment, error)
+	GetByMentionedUser(userID uint, page, limit int) ([]TaskComment, int64, error)
+	Update(comment *TaskComment, mentions []TaskCommentMention) error
+	Delete(id uint) error
+}
+
+// TaskCommentUsecase interface
+type TaskCommentUsecase interface {
+	AddComment(actorID, actorRoleID, taskID uint, body string) (*TaskComment, error)
+	GetComments(actorID, actorRoleID, taskID uint) ([]TaskComment, error)
+	UpdateComment(actorID, actorRoleID, taskID, commentID uint, body string) (*TaskComment, error)
+	DeleteComment(actorID, actorRoleID, taskID, commentID uint) error
+	GetMentions(actorID uint, page, limit int) ([]TaskComment, int64, error)
+}
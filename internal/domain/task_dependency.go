@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// TaskDependency records that a task is blocked by another task until that one is done
+type TaskDependency struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	TaskID      uint      `gorm:"not null;uniqueIndex:idx_task_dependency" json:"task_id"`
+	BlockedByID uint      `gorm:"not null;uniqueIndex:idx_task_dependency;index" json:"blocked_by_id"`
+	CreatedByID uint      `gorm:"not null" json:"created_by_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TaskDependency model
+func (TaskDependency) TableName() string {
+	return "task_dependencies"
+}
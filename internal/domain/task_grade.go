@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// TaskGrade keeps every grade given to a task so regrades never overwrite history
+type TaskGrade struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	TaskID     uint      `gorm:"not null;index" json:"task_id"`
+	Score      int       `gorm:"not null" json:"score"` // 0-100
+	Feedback   string    `json:"feedback"`
+	GradedByID uint      `gorm:"not null" json:"graded_by_id"`
+	GradedBy   User      `gorm:"foreignKey:GradedByID" json:"graded_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TaskGrade model
+func (TaskGrade) TableName() string {
+	return "task_grades"
+}
@@ -0,0 +1,89 @@
+package domain
+
+import "time"
+
+// Recurrence frequencies
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+// TaskRecurrence is a rule that repeatedly assigns a task template to a cohort of interns,
+// e.g. a weekly progress report every Friday
+type TaskRecurrence struct {
+	ID          uint         `gorm:"primaryKey" json:"id"`
+	TemplateID  uint         `gorm:"not null;index" json:"template_id"`
+	Template    TaskTemplate `gorm:"foreignKey:TemplateID" json:"template"`
+	Batch       string       `json:"batch"`
+	Division    string       `json:"division"`
+	PICID       *uint        `json:"pic_id"`                    // set when created by a PIC: only their interns
+	Frequency   string       `gorm:"not null" json:"frequency"` // daily, weekly, monthly
+	Weekday     *int         `json:"weekday"`                   // weekly: 0 = Sunday ... 6 = Saturday
+	DayOfMonth  *int         `json:"day_of_month"`              // monthly: 1-31, clamped to the month's last day
+	StartDate   time.Time    `gorm:"type:date;not null" json:"start_date"`
+	EndDate     *time.Time   `gorm:"type:date" json:"end_date"` // nil = each intern's EndDate
+	Active      bool         `gorm:"not null;default:true" json:"active"`
+	CreatedByID uint         `gorm:"not null" json:"created_by_id"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// TableName specifies the table name for TaskRecurrence model
+func (TaskRecurrence) TableName() string {
+	return "task_recurrences"
+}
+
+// SkippedOccurrence records an occurrence whose task was deleted, so that
+// generation does not create it again
+type SkippedOccurrence struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	RecurrenceID   uint      `gorm:"not null;uniqueIndex:idx_skipped_occurrence,priority:1" json:"recurrence_id"`
+	InternID       uint      `gorm:"not null;uniqueIndex:idx_skipped_occurrence,priority:2" json:"intern_id"`
+	OccurrenceDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_skipped_occurrence,priority:3" json:"occurrence_date"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for SkippedOccurrence model
+func (SkippedOccurrence) TableName() string {
+	return "task_skipped_occurrences"
+}
+
+// OccursOn reports whether the rule produces an occurrence on the given day
+func (r *TaskRecurrence) OccursOn(day time.Time) bool {
+	switch r.Frequency {
+	case RecurrenceDaily:
+		return true
+	case RecurrenceWeekly:
+		return r.Weekday != nil && int(day.Weekday()) == *r.Weekday
+	case RecurrenceMonthly:
+		if r.DayOfMonth == nil {
+			return false
+		}
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		target := *r.DayOfMonth
+		if target > lastDay {
+			target = lastDay
+		}
+		return day.Day() == target
+	}
+	return false
+}
+
+// TaskRecurrenceRepository interface
+type TaskRecurrenceRepository interface {
+	Create(recurrence *TaskRecurrence) error
+	GetByID(id uint) (*TaskRecurrence, error)
+	GetByTemplateID(templateID uint) ([]TaskRecurrence, error)
+	GetActive() ([]TaskRecurrence, error)
+	Update(recurrence *TaskRecurrence) error
+	GetSkipped(recurrenceID uint, from, to time.Time) ([]SkippedOccurrence, error)
+}
+
+// TaskRecurrenceUsecase interface
+type TaskRecurrenceUsecase interface {
+	CreateRecurrence(actorID, actorRoleID, templateID uint, batch, division, frequency string, weekday, dayOfMonth *int, startDate time.Time, endDate *time.Time) (*TaskRecurrence, error)
+	GetRecurrences(templateID uint) ([]TaskRecurrence, error)
+	DeactivateRecurrence(actorID, actorRoleID, templateID, id uint) error
+	GenerateOccurrences(now time.Time) error
+}
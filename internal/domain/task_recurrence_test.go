@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTaskRecurrenceOccursOn(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		name       string
+		recurrence TaskRecurrence
+		day        time.Time
+		want       bool
+	}{
+		{"daily", TaskRecurrence{Frequency: RecurrenceDaily}, date(2026, 3, 7), true},
+		{"weekly on its weekday", TaskRecurrence{Frequency: RecurrenceWeekly, Weekday: intPtr(5)}, date(2026, 3, 6), true},
+		{"weekly on another weekday", TaskRecurrence{Frequency: RecurrenceWeekly, Weekday: intPtr(5)}, date(2026, 3, 5), false},
+		{"weekly on sunday", TaskRecurrence{Frequency: RecurrenceWeekly, Weekday: intPtr(0)}, date(2026, 3, 8), true},
+		{"weekly without weekday", TaskRecurrence{Frequency: RecurrenceWeekly}, date(2026, 3, 6), false},
+		{"monthly on its day", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(15)}, date(2026, 3, 15), true},
+		{"monthly on another day", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(15)}, date(2026, 3, 16), false},
+		{"monthly 31st in a 31-day month", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(31)}, date(2026, 3, 31), true},
+		{"monthly 31st clamped to april 30", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(31)}, date(2026, 4, 30), true},
+		{"monthly 31st clamped to february 28", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(31)}, date(2026, 2, 28), true},
+		{"monthly 31st clamped to leap february 29", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(31)}, date(2028, 2, 29), true},
+		{"monthly 31st not on leap february 28", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(31)}, date(2028, 2, 28), false},
+		{"monthly 30th not on the 31st", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(30)}, date(2026, 3, 31), false},
+		{"monthly in december", TaskRecurrence{Frequency: RecurrenceMonthly, DayOfMonth: intPtr(31)}, date(2026, 12, 31), true},
+		{"monthly without day", TaskRecurrence{Frequency: RecurrenceMonthly}, date(2026, 3, 15), false},
+		{"unknown frequency", TaskRecurrence{Frequency: "yearly"}, date(2026, 3, 15), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.recurrence.OccursOn(tt.day); got != tt.want {
+				t.Errorf("OccursOn(%s) = %v, want %v", tt.day.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+}
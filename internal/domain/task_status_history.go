@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// TaskStatusHistory records every status transition of a task
+type TaskStatusHistory struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	TaskID      uint      `gorm:"not null;index" json:"task_id"`
+	FromStatus  string    `gorm:"not null" json:"from_status"`
+	ToStatus    string    `gorm:"not null" json:"to_status"`
+	ChangedByID uint      `gorm:"not null" json:"changed_by_id"`
+	ChangedBy   User      `gorm:"foreignKey:ChangedByID" json:"changed_by"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for TaskStatusHistory model
+func (TaskStatusHistory) TableName() string {
+	return "task_status_histories"
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// TaskTemplate is a reusable task definition that can be assigned to many interns at once
+type TaskTemplate struct {
+	ID                 uint      `gorm:"primaryKey" json:"id"`
+	Title              string    `gorm:"not null" json:"title"`
+	Description        string    `json:"description"`
+	DeadlineOffsetDays int       `gorm:"not null;default:0" json:"deadline_offset_days"` // days after the intern's StartDate
+	CreatedByID        uint      `gorm:"not null" json:"created_by_id"`
+	CreatedBy          User      `gorm:"foreignKey:CreatedByID" json:"created_by"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for TaskTemplate model
+func (TaskTemplate) TableName() string {
+	return "task_templates"
+}
+
+// TaskTemplateRepository interface
+type TaskTemplateRepository interface {
+	Create(template *TaskTemplate) error
+	GetByID(id uint) (*TaskTemplate, error)
+	GetAll(page, limit int) ([]TaskTemplate, int64, error)
+	Update(template *TaskTemplate) error
+	Delete(id uint) error
+}
+
+// TaskTemplateUsecase interface
+type TaskTemplateUsecase interface {
+	CreateTemplate(actorID uint, title, description string, deadlineOffsetDays int) (*TaskTemplate, error)
+	GetTemplateByID(id uint) (*TaskTemplate, error)
+	GetAllTemplates(page, limit int) ([]TaskTemplate, int64, error)
+	UpdateTemplate(actorID, actorRoleID, id uint, title, description string, deadlineOffsetDays int) (*TaskTemplate, error)
+	DeleteTemplate(actorID, actorRoleID, id uint) error
+	AssignTemplate(actorID, actorRoleID, id uint, batch, division string) ([]Task, error)
+}
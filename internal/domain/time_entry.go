@@ -0,0 +1,76 @@
+package domain
+
+import "time"
+
+// Time entry review states
+const (
+	TimeEntryPending  = "pending"
+	TimeEntryApproved = "approved"
+	TimeEntryRejected = "rejected"
+)
+
+// TimeEntry is time an intern spent on a task, either tracked with a timer or logged manually
+type TimeEntry struct {
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	TaskID          uint       `gorm:"not null;index" json:"task_id"`
+	Task            *Task      `gorm:"foreignKey:TaskID" json:"task,omitempty"`
+	InternID        uint       `gorm:"not null;index;uniqueIndex:idx_running_timer,where:ended_at IS NULL" json:"intern_id"`
+	StartedAt       time.Time  `gorm:"not null" json:"started_at"`
+	EndedAt         *time.Time `json:"ended_at"` // nil while the timer is running
+	DurationMinutes int        `gorm:"not null;default:0" json:"duration_minutes"`
+	Note            string     `json:"note"`
+	Source          string     `gorm:"not null" json:"source"`                 // timer, manual
+	Status          string     `gorm:"not null;default:pending" json:"status"` // pending, approved, rejected
+	ReviewedByID    *uint      `json:"reviewed_by_id"`
+	ReviewedAt      *time.Time `json:"reviewed_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for TimeEntry model
+func (TimeEntry) TableName() string {
+	return "task_time_entries"
+}
+
+// TaskTimeTotal is the time logged against one task within a period
+type TaskTimeTotal struct {
+	TaskID  uint   `json:"task_id"`
+	Title   string `json:"title"`
+	Minutes int    `json:"minutes"`
+}
+
+// Timesheet summarises an intern's time entries over a period
+type Timesheet struct {
+	InternID        uint            `json:"intern_id"`
+	From            time.Time       `json:"from"`
+	To              time.Time       `json:"to"`
+	Entries         []TimeEntry     `json:"entries"`
+	DailyTotals     map[string]int  `json:"daily_totals"` // minutes per YYYY-MM-DD
+	TaskTotals      []TaskTimeTotal `json:"task_totals"`
+	TotalMinutes    int             `json:"total_minutes"`
+	ApprovedMinutes int             `json:"approved_minutes"`
+}
+
+// TimeEntryRepository interface
+type TimeEntryRepository interface {
+	Create(entry *TimeEntry) error
+	GetByID(id uint) (*TimeEntry, error)
+	GetRunning(internID uint) (*TimeEntry, error)
+	GetByTaskID(taskID uint) ([]TimeEntry, error)
+	GetByInternAndPeriod(internID uint, from, to time.Time) ([]TimeEntry, error)
+	Update(entry *TimeEntry) error
+	Delete(id uint) error
+	ApprovePeriod(internID uint, from, to time.Time, reviewerID uint, reviewedAt time.Time) (int64, error)
+}
+
+// TimeEntryUsecase interface
+type TimeEntryUsecase interface {
+	StartTimer(actorID, actorRoleID, taskID uint, note string) (*TimeEntry, error)
+	StopTimer(actorID, actorRoleID uint) (*TimeEntry, error)
+	LogTime(actorID, actorRoleID, taskID uint, startedAt time.Time, durationMinutes int, note string) (*TimeEntry, error)
+	GetTaskEntries(actorID, actorRoleID, taskID uint) ([]TimeEntry, int, error)
+	DeleteEntry(actorID, actorRoleID, id uint) error
+	ReviewEntry(actorID, actorRoleID, id uint, approve bool) (*TimeEntry, error)
+	GetTimesheet(actorID, actorRoleID, internID uint, from, to time.Time) (*Timesheet, error)
+	ApproveTimesheet(actorID, actorRoleID, internID uint, from, to time.Time) (int64, error)
+}
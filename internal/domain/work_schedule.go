@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkSchedule defines office hours for a division and/or batch.
+// Empty Division and Batch act as wildcards; the schedule with both empty is the default.
+type WorkSchedule struct {
+	ID                 uint      `gorm:"primaryKey" json:"id"`
+	Division           string    `gorm:"not null;default:'';uniqueIndex:idx_work_schedule_cohort,priority:1" json:"division"`
+	Batch              string    `gorm:"not null;default:'';uniqueIndex:idx_work_schedule_cohort,priority:2" json:"batch"`
+	StartTime          string    `gorm:"not null" json:"start_time"` // HH:MM, office timezone
+	EndTime            string    `gorm:"not null" json:"end_time"`   // HH:MM, office timezone
+	GracePeriodMinutes int       `gorm:"not null;default:0" json:"grace_period_minutes"`
+	WorkingDays        string    `gorm:"not null" json:"working_days"` // comma separated, 0 = Sunday ... 6 = Saturday
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for WorkSchedule model
+func (WorkSchedule) TableName() string {
+	return "work_schedules"
+}
+
+// IsWorkingDay reports whether the schedule has office hours on the given day
+func (s *WorkSchedule) IsWorkingDay(day time.Time) bool {
+	weekday := strconv.Itoa(int(day.Weekday()))
+	for _, d := range strings.Split(s.WorkingDays, ",") {
+		if strings.TrimSpace(d) == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// StartOn returns the moment office hours begin on the given day
+func (s *WorkSchedule) StartOn(day time.Time) time.Time {
+	return clockOn(day, s.StartTime)
+}
+
+// EndOn returns the moment office hours end on the given day
+func (s *WorkSchedule) EndOn(day time.Time) time.Time {
+	return clockOn(day, s.EndTime)
+}
+
+// LateMinutes returns how many minutes after the start time a check-in happened,
+// or 0 when it falls within the grace period
+func (s *WorkSchedule) LateMinutes(checkIn time.Time) int {
+	late := int(checkIn.Sub(s.StartOn(checkIn)).Minutes())
+	if late <= s.GracePeriodMinutes {
+		return 0
+	}
+	return late
+}
+
+// clockOn combines the calendar day of day with an HH:MM clock time
+func clockOn(day time.Time, clock string) time.Time {
+	t, _ := time.Parse("15:04", clock)
+	y, m, d := day.Date()
+	return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, day.Location())
+}
+
+// WorkScheduleRepository interface
+type WorkScheduleRepository interface {
+	Create(schedule *WorkSchedule) error
+	GetByID(id uint) (*WorkSchedule, error)
+	GetByCohort(division, batch string) (*WorkSchedule, error)
+	FindFor(division, batch string) (*WorkSchedule, error)
+	GetAll() ([]WorkSchedule, error)
+	Update(schedule *WorkSchedule) error
+	Delete(id uint) error
+}
+
+// WorkScheduleUsecase interface
+type WorkScheduleUsecase interface {
+	CreateSchedule(division, batch, startTime, endTime string, gracePeriodMinutes int, workingDays []int) (*WorkSchedule, error)
+	GetSchedules() ([]WorkSchedule, error)
+	GetScheduleByID(id uint) (*WorkSchedule, error)
+	GetScheduleForIntern(userID uint) (*WorkSchedule, error)
+	UpdateSchedule(id uint, division, batch, startTime, endTime string, gracePeriodMinutes int, workingDays []int) (*WorkSchedule, error)
+	DeleteSchedule(id uint) error
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type attendanceCorrectionRepository struct {
+	db *gorm.DB
+}
+
+// NewAttendanceCorrectionRepository creates a new attendance correction repository
+func NewAttendanceCorrectionRepository(db *gorm.DB) domain.AttendanceCorrectionRepository {
+	return &attendanceCorrectionRepository{db: db}
+}
+
+// Create creates a new attendance correction
+func (r *attendanceCorrectionRepository) Create(correction *domain.AttendanceCorrection) error {
+	return r.db.Omit(clause.Associations).Create(correction).Error
+}
+
+// GetByID gets an attendance correction by ID
+func (r *attendanceCorrectionRepository) GetByID(id uint) (*domain.AttendanceCorrection, error) {
+	var correction domain.AttendanceCorrection
+	err := r.db.Preload("Intern").Preload("ReviewedBy").First(&correction, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCorrectionNotFound
+		}
+		return nil, err
+	}
+	return &correction, nil
+}
+
+// GetAll gets attendance corrections matching the filter with pagination, newest first
+func (r *attendanceCorrectionRepository) GetAll(filter domain.AttendanceCorrectionFilter, page, limit int) ([]domain.AttendanceCorrection, int64, error) {
+	var corrections []domain.AttendanceCorrection
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.db.Model(&domain.AttendanceCorrection{})
+
+	if filter.InternID != 0 {
+		query = query.Where("intern_id = ?", filter.InternID)
+	}
+	if filter.PICID != 0 {
+		query = query.Where("intern_id IN (?)",
+			r.db.Model(&domain.InternProfile{}).Select("user_id").Where("pic_id = ?", filter.PICID))
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	query = query.Session(&gorm.Session{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := query.Preload("Intern").Preload("ReviewedBy").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&corrections).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return corrections, total, nil
+}
+
+// GetPendingForDay gets the intern's pending correction for a calendar day
+func (r *attendanceCorrectionRepository) GetPendingForDay(internID uint, date time.Time) (*domain.AttendanceCorrection, error) {
+	var correction domain.AttendanceCorrection
+	err := r.db.Where("intern_id = ? AND date = ? AND status = ?", internID, date, domain.CorrectionPending).
+		First(&correction).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCorrectionNotFound
+		}
+		return nil, err
+	}
+	return &correction, nil
+}
+
+// Update updates an attendance correction
+func (r *attendanceCorrectionRepository) Update(correction *domain.AttendanceCorrection) error {
+	return r.db.Omit(clause.Associations).Save(correction).Error
+}
+
+// Approve writes the corrected attendance record, saves the approved correction linked to
+// it and records the audit entry in one transaction
+func (r *attendanceCorrectionRepository) Approve(correction *domain.AttendanceCorrection, attendance *domain.Attendance, audit *domain.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(attendance).Error; err != nil {
+			return err
+		}
+
+		correction.AttendanceID = &attendance.ID
+		if err := tx.Omit(clause.Associations).Save(correction).Error; err != nil {
+			return err
+		}
+
+		audit.EntityID = &attendance.ID
+		return tx.Omit(clause.Associations).Create(audit).Error
+	})
+}
+
+// Delete deletes an attendance correction
+func (r *attendanceCorrectionRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.AttendanceCorrection{}, id).Error
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type attendanceRepository struct {
+	db *gorm.DB
+}
+
+// NewAttendanceRepository creates a new attendance repository
+func NewAttendanceRepository(db *gorm.DB) domain.AttendanceRepository {
+	return &attendanceRepository{db: db}
+}
+
+// Create creates the intern's record for the day.
+// The unique (intern_id, date) index makes a second record for the same day a no-op.
+func (r *attendanceRepository) Create(attendance *domain.Attendance) error {
+	result := r.db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(attendance)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAlreadyCheckedIn
+	}
+	return nil
+}
+
+// CreateMissing inserts records for days that have none yet and returns how many were created.
+// Existing records for the same intern and day are left untouched.
+func (r *attendanceRepository) CreateMissing(records []domain.Attendance) (int64, error) {
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	result := r.db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(records, 100)
+	return result.RowsAffected, result.Error
+}
+
+// GetByID gets an attendance record by ID
+func (r *attendanceRepository) GetByID(id uint) (*domain.Attendance, error) {
+	var attendance domain.Attendance
+	err := r.db.Preload("Intern").First(&attendance, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAttendanceNotFound
+		}
+		return nil, err
+	}
+	return &attendance, nil
+}
+
+// GetByInternAndDate gets the intern's record for a calendar day
+func (r *attendanceRepository) GetByInternAndDate(internID uint, date time.Time) (*domain.Attendance, error) {
+	var attendance domain.Attendance
+	err := r.db.Where("intern_id = ? AND date = ?", internID, date).First(&attendance).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAttendanceNotFound
+		}
+		return nil, err
+	}
+	return &attendance, nil
+}
+
+// GetAll gets attendance records matching the filter with pagination, newest first
+func (r *attendanceRepository) GetAll(filter domain.AttendanceFilter, page, limit int) ([]domain.Attendance, int64, error) {
+	var records []domain.Attendance
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.applyFilter(r.db.Model(&domain.Attendance{}), filter).Session(&gorm.Session{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := query.Preload("Intern").
+		Order("date DESC, intern_id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&records).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, total, nil
+}
+
+// GetByInternsBetween gets the records of several interns within [from, to]
+func (r *attendanceRepository) GetByInternsBetween(internIDs []uint, from, to time.Time) ([]domain.Attendance, error) {
+	var records []domain.Attendance
+	if len(internIDs) == 0 {
+		return records, nil
+	}
+
+	err := r.db.Where("intern_id IN ? AND date >= ? AND date <= ?", internIDs, from, to).
+		Order("intern_id ASC, date ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// GetByInternBetween gets an intern's records within [from, to], in date order
+func (r *attendanceRepository) GetByInternBetween(internID uint, from, to time.Time) ([]domain.Attendance, error) {
+	var records []domain.Attendance
+	err := r.db.Where("intern_id = ? AND date >= ? AND date <= ?", internID, from, to).
+		Order("date ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Update updates an attendance record
+func (r *attendanceRepository) Update(attendance *domain.Attendance) error {
+	return r.db.Omit(clause.Associations).Save(attendance).Error
+}
+
+func (r *attendanceRepository) applyFilter(query *gorm.DB, filter domain.AttendanceFilter) *gorm.DB {
+	if filter.InternID != 0 {
+		query = query.Where("attendance.intern_id = ?", filter.InternID)
+	}
+	if filter.PICID != 0 {
+		query = query.Where("attendance.intern_id IN (?)",
+			r.db.Model(&domain.InternProfile{}).Select("user_id").Where("pic_id = ?", filter.PICID))
+	}
+	if filter.Status != "" {
+		query = query.Where("attendance.status = ?", filter.Status)
+	}
+	if filter.IsRemote != nil {
+		query = query.Where("attendance.is_remote = ?", *filter.IsRemote)
+	}
+	if filter.DateFrom != nil {
+		query = query.Where("attendance.date >= ?", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		query = query.Where("attendance.date <= ?", *filter.DateTo)
+	}
+	return query
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type holidayRepository struct {
+	db *gorm.DB
+}
+
+// NewHolidayRepository creates a new holiday repository
+func NewHolidayRepository(db *gorm.DB) domain.HolidayRepository {
+	return &holidayRepository{db: db}
+}
+
+// Create creates a new holiday and retracts the alpha records already marked on its day
+func (r *holidayRepository) Create(holiday *domain.Holiday) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(holiday).Error; err != nil {
+			return err
+		}
+		return retractAlpha(tx, []time.Time{holiday.Date})
+	})
+}
+
+// CreateMissing inserts holidays on days that have none yet and returns how many were created.
+// Alpha records already marked on those days are retracted.
+func (r *holidayRepository) CreateMissing(holidays []domain.Holiday) (int64, error) {
+	if len(holidays) == 0 {
+		return 0, nil
+	}
+
+	var created int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(holidays, 100)
+		if result.Error != nil {
+			return result.Error
+		}
+		created = result.RowsAffected
+
+		dates := make([]time.Time, 0, len(holidays))
+		for _, holiday := range holidays {
+			dates = append(dates, holiday.Date)
+		}
+		return retractAlpha(tx, dates)
+	})
+	return created, err
+}
+
+// GetByID gets a holiday by ID
+func (r *holidayRepository) GetByID(id uint) (*domain.Holiday, error) {
+	var holiday domain.Holiday
+	err := r.db.First(&holiday, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrHolidayNotFound
+		}
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+// GetByDate gets the holiday on a calendar day
+func (r *holidayRepository) GetByDate(date time.Time) (*domain.Holiday, error) {
+	var holiday domain.Holiday
+	err := r.db.Where("date = ?", date).First(&holiday).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrHolidayNotFound
+		}
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+// GetBetween gets the holidays within [from, to], in date order
+func (r *holidayRepository) GetBetween(from, to time.Time) ([]domain.Holiday, error) {
+	var holidays []domain.Holiday
+	err := r.db.Where("date >= ? AND date <= ?", from, to).Order("date ASC").Find(&holidays).Error
+	if err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// Update updates a holiday and retracts the alpha records already marked on its new day
+func (r *holidayRepository) Update(holiday *domain.Holiday) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(holiday).Error; err != nil {
+			return err
+		}
+		return retractAlpha(tx, []time.Time{holiday.Date})
+	})
+}
+
+// Delete deletes a holiday
+func (r *holidayRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.Holiday{}, id).Error
+}
+
+// retractAlpha deletes the alpha records on the given days. Alpha is only marked on working
+// days, so a day that became a holiday has no record like any other day off.
+func retractAlpha(tx *gorm.DB, dates []time.Time) error {
+	return tx.Where("status = ? AND date IN ?", domain.AttendanceAlpha, dates).Delete(&domain.Attendance{}).Error
+}
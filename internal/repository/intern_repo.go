@@ -45,7 +45,7 @@ func (r *internRepository) GetByID(id uint) (*domain.InternProfile, error) {
 	err := r.db.Preload("User").Preload("PIC").First(&profile, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("intern profile not found")
+			return nil, domain.ErrInternNotFound
 		}
 		return nil, err
 	}
@@ -58,7 +58,7 @@ func (r *internRepository) GetByUserID(userID uint) (*domain.InternProfile, erro
 	err := r.db.Preload("User").Preload("PIC").Where("user_id = ?", userID).First(&profile).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("intern profile not found")
+			return nil, domain.ErrInternNotFound
 		}
 		return nil, err
 	}
@@ -90,12 +90,49 @@ func (r *internRepository) GetAll(page, limit int) ([]domain.InternProfile, int6
 	return profiles, total, nil
 }
 
+// GetActiveBetween gets intern profiles whose internship overlaps the [from, to] date range
+func (r *internRepository) GetActiveBetween(from, to time.Time) ([]domain.InternProfile, error) {
+	var profiles []domain.InternProfile
+
+	err := r.db.Where("start_date <= ? AND end_date >= ?", to, from).
+		Order("id ASC").
+		Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// FindByCohort gets intern profiles with their user by batch and/or division.
+// Empty values are ignored; a non-zero picID limits the result to that PIC's interns.
+func (r *internRepository) FindByCohort(batch, division string, picID uint) ([]domain.InternProfile, error) {
+	var profiles []domain.InternProfile
+
+	query := r.db.Model(&domain.InternProfile{}).Preload("User")
+	if batch != "" {
+		query = query.Where("batch = ?", batch)
+	}
+	if division != "" {
+		query = query.Where("division = ?", division)
+	}
+	if picID != 0 {
+		query = query.Where("pic_id = ?", picID)
+	}
+
+	if err := query.Order("id ASC").Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
 // Update updates an intern profile
 func (r *internRepository) Update(id uint, batch, division, university, major string) (*domain.InternProfile, error) {
 	var profile domain.InternProfile
 	if err := r.db.First(&profile, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("intern profile not found")
+			return nil, domain.ErrInternNotFound
 		}
 		return nil, err
 	}
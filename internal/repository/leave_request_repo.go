@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type leaveRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewLeaveRequestRepository creates a new leave request repository
+func NewLeaveRequestRepository(db *gorm.DB) domain.LeaveRequestRepository {
+	return &leaveRequestRepository{db: db}
+}
+
+// Create creates a new leave request
+func (r *leaveRequestRepository) Create(leave *domain.LeaveRequest) error {
+	return r.db.Omit(clause.Associations).Create(leave).Error
+}
+
+// GetByID gets a leave request by ID
+func (r *leaveRequestRepository) GetByID(id uint) (*domain.LeaveRequest, error) {
+	var leave domain.LeaveRequest
+	err := r.db.Preload("Intern").Preload("ReviewedBy").First(&leave, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrLeaveNotFound
+		}
+		return nil, err
+	}
+	return &leave, nil
+}
+
+// GetAll gets leave requests matching the filter with pagination, newest first
+func (r *leaveRequestRepository) GetAll(filter domain.LeaveRequestFilter, page, limit int) ([]domain.LeaveRequest, int64, error) {
+	var leaves []domain.LeaveRequest
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.db.Model(&domain.LeaveRequest{})
+	if filter.InternID != 0 {
+		query = query.Where("intern_id = ?", filter.InternID)
+	}
+	if filter.PICID != 0 {
+		query = query.Where("intern_id IN (?)",
+			r.db.Model(&domain.InternProfile{}).Select("user_id").Where("pic_id = ?", filter.PICID))
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	query = query.Session(&gorm.Session{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := query.Preload("Intern").Preload("ReviewedBy").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&leaves).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return leaves, total, nil
+}
+
+// GetOverlapping gets the intern's pending or approved requests that share a day with [from, to]
+func (r *leaveRequestRepository) GetOverlapping(internID uint, from, to time.Time) ([]domain.LeaveRequest, error) {
+	var leaves []domain.LeaveRequest
+	err := r.db.
+		Where("intern_id = ? AND status IN ?", internID, []string{domain.LeavePending, domain.LeaveApproved}).
+		Where("start_date <= ? AND end_date >= ?", to, from).
+		Find(&leaves).Error
+	if err != nil {
+		return nil, err
+	}
+	return leaves, nil
+}
+
+// SumDays totals the days of the intern's requests in the given state
+func (r *leaveRequestRepository) SumDays(internID uint, status string) (int, error) {
+	var total int
+	err := r.db.Model(&domain.LeaveRequest{}).
+		Select("COALESCE(SUM(days), 0)").
+		Where("intern_id = ? AND status = ?", internID, status).
+		Scan(&total).Error
+	return total, err
+}
+
+// Update updates a leave request
+func (r *leaveRequestRepository) Update(leave *domain.LeaveRequest) error {
+	return r.db.Omit(clause.Associations).Save(leave).Error
+}
+
+// Approve saves the approved request and writes its days as izin in one transaction.
+// Days without a record or marked alpha become izin; days the intern checked in are kept.
+func (r *leaveRequestRepository) Approve(leave *domain.LeaveRequest, records []domain.Attendance) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(leave).Error; err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		return tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "intern_id"}, {Name: "date"}},
+				DoUpdates: clause.AssignmentColumns([]string{"status", "updated_at"}),
+				Where: clause.Where{Exprs: []clause.Expression{
+					clause.Eq{Column: clause.Column{Table: "attendance", Name: "status"}, Value: domain.AttendanceAlpha},
+				}},
+			}).
+			Create(&records).Error
+	})
+}
+
+// Delete deletes a leave request
+func (r *leaveRequestRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.LeaveRequest{}, id).Error
+}
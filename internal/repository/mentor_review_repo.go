@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type mentorReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewMentorReviewRepository creates a new mentor review repository
+func NewMentorReviewRepository(db *gorm.DB) domain.MentorReviewRepository {
+	return &mentorReviewRepository{db: db}
+}
+
+// Create creates a new mentor review with its answers. The unique index on intern and
+// period turns a second review for the same period into ErrMentorReviewExists.
+func (r *mentorReviewRepository) Create(review *domain.MentorReview) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(review)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrMentorReviewExists
+		}
+		return createAnswers(tx, review)
+	})
+}
+
+// GetByID gets a mentor review by ID
+func (r *mentorReviewRepository) GetByID(id uint) (*domain.MentorReview, error) {
+	var review domain.MentorReview
+	err := r.db.Preload("Intern").Preload("PIC").
+		Preload("Rubric.Criteria", orderCriteria).Preload("Answers.Criterion").
+		First(&review, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrMentorReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetByInternAndPeriod gets the mentor review of an intern for a period
+func (r *mentorReviewRepository) GetByInternAndPeriod(internID uint, period string) (*domain.MentorReview, error) {
+	var review domain.MentorReview
+	err := r.db.Where("intern_id = ? AND period = ?", internID, period).First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrMentorReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetAll gets mentor reviews matching the filter with pagination, latest period first
+func (r *mentorReviewRepository) GetAll(filter domain.MentorReviewFilter, page, limit int) ([]domain.MentorReview, int64, error) {
+	var reviews []domain.MentorReview
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.db.Model(&domain.MentorReview{})
+
+	if filter.InternID != 0 {
+		query = query.Where("intern_id = ?", filter.InternID)
+	}
+	if filter.PICID != 0 {
+		query = query.Where("intern_id IN (?)",
+			r.db.Model(&domain.InternProfile{}).Select("user_id").Where("pic_id = ?", filter.PICID))
+	}
+	if filter.Period != "" {
+		query = query.Where("period = ?", filter.Period)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	query = query.Session(&gorm.Session{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := query.Preload("Intern").Preload("PIC").
+		Preload("Rubric.Criteria", orderCriteria).Preload("Answers.Criterion").
+		Order("period DESC, intern_id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&reviews).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+// Update updates a mentor review
+func (r *mentorReviewRepository) Update(review *domain.MentorReview) error {
+	return r.db.Omit(clause.Associations).Save(review).Error
+}
+
+// ReplaceAnswers updates a mentor review and replaces all of its answers
+func (r *mentorReviewRepository) ReplaceAnswers(review *domain.MentorReview) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(review).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("review_id = ?", review.ID).Delete(&domain.ReviewAnswer{}).Error; err != nil {
+			return err
+		}
+		return createAnswers(tx, review)
+	})
+}
+
+// createAnswers inserts the answers of a review that has just been saved
+func createAnswers(tx *gorm.DB, review *domain.MentorReview) error {
+	if len(review.Answers) == 0 {
+		return nil
+	}
+	for i := range review.Answers {
+		review.Answers[i].ID = 0
+		review.Answers[i].ReviewID = review.ID
+	}
+	return tx.Omit(clause.Associations).Create(&review.Answers).Error
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type officeLocationRepository struct {
+	db *gorm.DB
+}
+
+// NewOfficeLocationRepository creates a new office location repository
+func NewOfficeLocationRepository(db *gorm.DB) domain.OfficeLocationRepository {
+	return &officeLocationRepository{db: db}
+}
+
+// Create creates a new office location
+func (r *officeLocationRepository) Create(location *domain.OfficeLocation) error {
+	return r.db.Create(location).Error
+}
+
+// GetByID gets an office location by ID
+func (r *officeLocationRepository) GetByID(id uint) (*domain.OfficeLocation, error) {
+	var location domain.OfficeLocation
+	err := r.db.First(&location, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrOfficeLocationNotFound
+		}
+		return nil, err
+	}
+	return &location, nil
+}
+
+// GetAll gets all office locations
+func (r *officeLocationRepository) GetAll() ([]domain.OfficeLocation, error) {
+	var locations []domain.OfficeLocation
+	err := r.db.Order("division ASC, name ASC").Find(&locations).Error
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// GetForDivision gets the office locations that apply to interns of the division,
+// including those shared by every division
+func (r *officeLocationRepository) GetForDivision(division string) ([]domain.OfficeLocation, error) {
+	var locations []domain.OfficeLocation
+	err := r.db.Where("division IN ?", []string{division, ""}).Order("id ASC").Find(&locations).Error
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// Update updates an office location
+func (r *officeLocationRepository) Update(location *domain.OfficeLocation) error {
+	return r.db.Save(location).Error
+}
+
+// Delete deletes an office location
+func (r *officeLocationRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.OfficeLocation{}, id).Error
+}
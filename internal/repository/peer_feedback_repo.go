@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type peerFeedbackRepository struct {
+	db *gorm.DB
+}
+
+// NewPeerFeedbackRepository creates a new peer feedback repository
+func NewPeerFeedbackRepository(db *gorm.DB) domain.PeerFeedbackRepository {
+	return &peerFeedbackRepository{db: db}
+}
+
+// CreateRound creates a peer feedback round together with its assignments
+func (r *peerFeedbackRepository) CreateRound(round *domain.PeerFeedbackRound, assignments []domain.PeerFeedbackAssignment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(round).Error; err != nil {
+			return err
+		}
+		for i := range assignments {
+			assignments[i].RoundID = round.ID
+		}
+		return tx.Omit(clause.Associations).CreateInBatches(&assignments, 100).Error
+	})
+}
+
+// GetRoundByID gets a peer feedback round by ID with its rubric
+func (r *peerFeedbackRepository) GetRoundByID(id uint) (*domain.PeerFeedbackRound, error) {
+	var round domain.PeerFeedbackRound
+	err := r.db.Preload("Rubric.Criteria", orderCriteria).First(&round, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrPeerRoundNotFound
+		}
+		return nil, err
+	}
+	return &round, nil
+}
+
+// GetRounds gets peer feedback rounds with pagination, newest first
+func (r *peerFeedbackRepository) GetRounds(page, limit int) ([]domain.PeerFeedbackRound, int64, error) {
+	var rounds []domain.PeerFeedbackRound
+	var total int64
+
+	offset := (page - 1) * limit
+
+	// Count total
+	if err := r.db.Model(&domain.PeerFeedbackRound{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := r.db.Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&rounds).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rounds, total, nil
+}
+
+// CloseRound saves the closed round and writes the peer scores of its interns to their
+// potential scores of the period in one transaction. Missing potential scores are created
+// with only the peer score; their mentor average stays null.
+func (r *peerFeedbackRepository) CloseRound(round *domain.PeerFeedbackRound, scores []domain.PotentialScore) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(round).Error; err != nil {
+			return err
+		}
+		if len(scores) == 0 {
+			return nil
+		}
+
+		return tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "intern_id"}, {Name: "period"}},
+				DoUpdates: clause.AssignmentColumns([]string{"peer_score"}),
+			}).
+			Create(&scores).Error
+	})
+}
+
+// GetAssignmentByID gets a peer feedback assignment by ID with its round
+func (r *peerFeedbackRepository) GetAssignmentByID(id uint) (*domain.PeerFeedbackAssignment, error) {
+	var assignment domain.PeerFeedbackAssignment
+	err := r.db.Preload("Round.Rubric.Criteria", orderCriteria).Preload("Reviewee").First(&assignment, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrPeerAssignmentNotFound
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// GetAssignments gets the assignments of a reviewer, newest round first
+func (r *peerFeedbackRepository) GetAssignments(reviewerID uint, pendingOnly bool) ([]domain.PeerFeedbackAssignment, error) {
+	var assignments []domain.PeerFeedbackAssignment
+	query := r.db.Preload("Round.Rubric.Criteria", orderCriteria).Preload("Reviewee").
+		Where("reviewer_id = ?", reviewerID)
+	if pendingOnly {
+		query = query.Where("submitted_at IS NULL")
+	}
+	err := query.Order("round_id DESC, id ASC").Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// SubmitFeedback marks the assignment as done and stores the feedback, which does not
+// reference the reviewer, in one transaction
+func (r *peerFeedbackRepository) SubmitFeedback(assignment *domain.PeerFeedbackAssignment, feedback *domain.PeerFeedback) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.PeerFeedbackAssignment{}).
+			Where("id = ? AND submitted_at IS NULL", assignment.ID).
+			Update("submitted_at", assignment.SubmittedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrPeerFeedbackSubmitted
+		}
+
+		if err := tx.Omit(clause.Associations).Create(feedback).Error; err != nil {
+			return err
+		}
+		for i := range feedback.Answers {
+			feedback.Answers[i].FeedbackID = feedback.ID
+		}
+		return tx.Create(&feedback.Answers).Error
+	})
+}
+
+// GetFeedback gets all feedback given in a round with its answers
+func (r *peerFeedbackRepository) GetFeedback(roundID uint) ([]domain.PeerFeedback, error) {
+	var feedback []domain.PeerFeedback
+	err := r.db.Preload("Answers").Where("round_id = ?", roundID).Find(&feedback).Error
+	if err != nil {
+		return nil, err
+	}
+	return feedback, nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reviewCycleRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewCycleRepository creates a new review cycle repository
+func NewReviewCycleRepository(db *gorm.DB) domain.ReviewCycleRepository {
+	return &reviewCycleRepository{db: db}
+}
+
+// Create creates a new review cycle. The unique index on period turns a second
+// cycle for the same period into ErrReviewCycleExists.
+func (r *reviewCycleRepository) Create(cycle *domain.ReviewCycle) error {
+	result := r.db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(cycle)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrReviewCycleExists
+	}
+	return nil
+}
+
+// GetByID gets a review cycle by ID with its reopenings
+func (r *reviewCycleRepository) GetByID(id uint) (*domain.ReviewCycle, error) {
+	var cycle domain.ReviewCycle
+	err := r.db.Preload("OpenedBy").Preload("Reopenings.Intern").First(&cycle, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrReviewCycleNotFound
+		}
+		return nil, err
+	}
+	return &cycle, nil
+}
+
+// GetByPeriod gets the review cycle of a period
+func (r *reviewCycleRepository) GetByPeriod(period string) (*domain.ReviewCycle, error) {
+	var cycle domain.ReviewCycle
+	err := r.db.Where("period = ?", period).First(&cycle).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrReviewCycleNotFound
+		}
+		return nil, err
+	}
+	return &cycle, nil
+}
+
+// GetAll gets review cycles with pagination, latest period first
+func (r *reviewCycleRepository) GetAll(page, limit int) ([]domain.ReviewCycle, int64, error) {
+	var cycles []domain.ReviewCycle
+	var total int64
+
+	offset := (page - 1) * limit
+
+	// Count total
+	if err := r.db.Model(&domain.ReviewCycle{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := r.db.Preload("OpenedBy").
+		Order("period DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&cycles).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cycles, total, nil
+}
+
+// Update updates a review cycle
+func (r *reviewCycleRepository) Update(cycle *domain.ReviewCycle) error {
+	return r.db.Omit(clause.Associations).Save(cycle).Error
+}
+
+// GetReopening gets the reopening of a cycle for an intern
+func (r *reviewCycleRepository) GetReopening(cycleID, internID uint) (*domain.ReviewReopening, error) {
+	var reopening domain.ReviewReopening
+	err := r.db.Where("cycle_id = ? AND intern_id = ?", cycleID, internID).First(&reopening).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrReopeningNotFound
+		}
+		return nil, err
+	}
+	return &reopening, nil
+}
+
+// Reopen saves the reopening, replacing an earlier one for the same intern, and moves
+// the intern's review of the period back to draft in one transaction
+func (r *reviewCycleRepository) Reopen(reopening *domain.ReviewReopening, period string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "cycle_id"}, {Name: "intern_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"deadline", "reason", "reopened_by_id", "updated_at"}),
+			}).
+			Create(reopening).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.MentorReview{}).
+			Where("intern_id = ? AND period = ?", reopening.InternID, period).
+			Updates(map[string]interface{}{
+				"status":       domain.ReviewDraft,
+				"submitted_at": nil,
+				"updated_at":   reopening.UpdatedAt,
+			}).Error
+	})
+}
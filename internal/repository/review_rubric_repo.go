@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type reviewRubricRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewRubricRepository creates a new review rubric repository
+func NewReviewRubricRepository(db *gorm.DB) domain.ReviewRubricRepository {
+	return &reviewRubricRepository{db: db}
+}
+
+// Create creates a new rubric together with its criteria
+func (r *reviewRubricRepository) Create(rubric *domain.ReviewRubric) error {
+	return r.db.Create(rubric).Error
+}
+
+// GetByID gets a rubric by ID with its criteria in order
+func (r *reviewRubricRepository) GetByID(id uint) (*domain.ReviewRubric, error) {
+	var rubric domain.ReviewRubric
+	err := r.db.Preload("Criteria", orderCriteria).First(&rubric, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrRubricNotFound
+		}
+		return nil, err
+	}
+	return &rubric, nil
+}
+
+// GetAll gets every rubric version, optionally only those of one division
+func (r *reviewRubricRepository) GetAll(division string) ([]domain.ReviewRubric, error) {
+	var rubrics []domain.ReviewRubric
+	query := r.db.Preload("Criteria", orderCriteria)
+	if division != "" {
+		query = query.Where("division = ?", division)
+	}
+	err := query.Order("division ASC, version DESC").Find(&rubrics).Error
+	if err != nil {
+		return nil, err
+	}
+	return rubrics, nil
+}
+
+// FindFor gets the rubric that applies to a division in a period: the latest version
+// effective by then, preferring the division's own rubrics over the shared ones
+func (r *reviewRubricRepository) FindFor(division, period string) (*domain.ReviewRubric, error) {
+	var rubric domain.ReviewRubric
+	err := r.db.Preload("Criteria", orderCriteria).
+		Where("division IN ? AND effective_period <= ?", []string{division, ""}, period).
+		Order("division DESC, effective_period DESC, version DESC").
+		First(&rubric).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrRubricNotFound
+		}
+		return nil, err
+	}
+	return &rubric, nil
+}
+
+// LatestVersion gets the highest rubric version of a division, 0 if it has none
+func (r *reviewRubricRepository) LatestVersion(division string) (int, error) {
+	var version int
+	err := r.db.Model(&domain.ReviewRubric{}).
+		Where("division = ?", division).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	return version, err
+}
+
+// IsUsed reports whether any mentor review, self-assessment or peer feedback round uses the rubric
+func (r *reviewRubricRepository) IsUsed(id uint) (bool, error) {
+	for _, model := range []interface{}{&domain.MentorReview{}, &domain.SelfAssessment{}, &domain.PeerFeedbackRound{}} {
+		var count int64
+		if err := r.db.Model(model).Where("rubric_id = ?", id).Count(&count).Error; err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Delete deletes a rubric and its criteria
+func (r *reviewRubricRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("rubric_id = ?", id).Delete(&domain.RubricCriterion{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.ReviewRubric{}, id).Error
+	})
+}
+
+// orderCriteria keeps rubric criteria in the order HR defined them
+func orderCriteria(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
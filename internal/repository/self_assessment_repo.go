@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type selfAssessmentRepository struct {
+	db *gorm.DB
+}
+
+// NewSelfAssessmentRepository creates a new self-assessment repository
+func NewSelfAssessmentRepository(db *gorm.DB) domain.SelfAssessmentRepository {
+	return &selfAssessmentRepository{db: db}
+}
+
+// Create creates a new self-assessment with its answers. The unique index on intern and
+// period turns a second self-assessment for the same period into ErrSelfAssessmentExists.
+func (r *selfAssessmentRepository) Create(assessment *domain.SelfAssessment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(assessment)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrSelfAssessmentExists
+		}
+		return createSelfAssessmentAnswers(tx, assessment)
+	})
+}
+
+// GetByID gets a self-assessment by ID
+func (r *selfAssessmentRepository) GetByID(id uint) (*domain.SelfAssessment, error) {
+	var assessment domain.SelfAssessment
+	err := r.db.Preload("Intern").
+		Preload("Rubric.Criteria", orderCriteria).Preload("Answers.Criterion").
+		First(&assessment, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrSelfAssessmentNotFound
+		}
+		return nil, err
+	}
+	return &assessment, nil
+}
+
+// GetByInternAndPeriod gets the self-assessment of an intern for a period
+func (r *selfAssessmentRepository) GetByInternAndPeriod(internID uint, period string) (*domain.SelfAssessment, error) {
+	var assessment domain.SelfAssessment
+	err := r.db.Preload("Intern").
+		Preload("Rubric.Criteria", orderCriteria).Preload("Answers.Criterion").
+		Where("intern_id = ? AND period = ?", internID, period).
+		First(&assessment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrSelfAssessmentNotFound
+		}
+		return nil, err
+	}
+	return &assessment, nil
+}
+
+// GetAll gets self-assessments matching the filter with pagination, latest period first
+func (r *selfAssessmentRepository) GetAll(filter domain.SelfAssessmentFilter, page, limit int) ([]domain.SelfAssessment, int64, error) {
+	var assessments []domain.SelfAssessment
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.db.Model(&domain.SelfAssessment{})
+
+	if filter.InternID != 0 {
+		query = query.Where("intern_id = ?", filter.InternID)
+	}
+	if filter.PICID != 0 {
+		query = query.Where("intern_id IN (?)",
+			r.db.Model(&domain.InternProfile{}).Select("user_id").Where("pic_id = ?", filter.PICID))
+	}
+	if filter.Period != "" {
+		query = query.Where("period = ?", filter.Period)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.MentorReviewed {
+		query = query.Where("EXISTS (?)",
+			r.db.Model(&domain.MentorReview{}).Select("1").
+				Where("mentor_reviews.intern_id = self_assessments.intern_id AND mentor_reviews.period = self_assessments.period AND mentor_reviews.status = ?", domain.ReviewSubmitted))
+	}
+	query = query.Session(&gorm.Session{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := query.Preload("Intern").
+		Preload("Rubric.Criteria", orderCriteria).Preload("Answers.Criterion").
+		Order("period DESC, intern_id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&assessments).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return assessments, total, nil
+}
+
+// Update updates a self-assessment
+func (r *selfAssessmentRepository) Update(assessment *domain.SelfAssessment) error {
+	return r.db.Omit(clause.Associations).Save(assessment).Error
+}
+
+// ReplaceAnswers updates a self-assessment and replaces all of its answers
+func (r *selfAssessmentRepository) ReplaceAnswers(assessment *domain.SelfAssessment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(assessment).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("assessment_id = ?", assessment.ID).Delete(&domain.SelfAssessmentAnswer{}).Error; err != nil {
+			return err
+		}
+		return createSelfAssessmentAnswers(tx, assessment)
+	})
+}
+
+// createSelfAssessmentAnswers inserts the answers of a self-assessment that has just been saved
+func createSelfAssessmentAnswers(tx *gorm.DB, assessment *domain.SelfAssessment) error {
+	if len(assessment.Answers) == 0 {
+		return nil
+	}
+	for i := range assessment.Answers {
+		assessment.Answers[i].ID = 0
+		assessment.Answers[i].AssessmentID = assessment.ID
+	}
+	return tx.Omit(clause.Associations).Create(&assessment.Answers).Error
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type taskAttachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskAttachmentRepository creates a new task attachment repository
+func NewTaskAttachmentRepository(db *gorm.DB) domain.TaskAttachmentRepository {
+	return &taskAttachmentRepository{db: db}
+}
+
+// Create creates a new attachment record
+func (r *taskAttachmentRepository) Create(attachment *domain.TaskAttachment) error {
+	return r.db.Omit("Uploader").Create(attachment).Error
+}
+
+// GetByID gets an attachment by ID
+func (r *taskAttachmentRepository) GetByID(id uint) (*domain.TaskAttachment, error) {
+	var attachment domain.TaskAttachment
+	err := r.db.Preload("Uploader").First(&attachment, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// GetByTaskID gets all attachments of a task, oldest first
+func (r *taskAttachmentRepository) GetByTaskID(taskID uint) ([]domain.TaskAttachment, error) {
+	var attachments []domain.TaskAttachment
+	err := r.db.Preload("Uploader").
+		Where("task_id = ?", taskID).
+		Order("created_at ASC, id ASC").
+		Find(&attachments).Error
+	if err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// Delete deletes an attachment record
+func (r *taskAttachmentRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.TaskAttachment{}, id).Error
+}
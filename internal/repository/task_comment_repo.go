@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type taskCommentRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskCommentRepository creates a new task comment repository
+func NewTaskCommentRepository(db *gorm.DB) domain.TaskCommentRepository {
+	return &taskCommentRepository{db: db}
+}
+
+// Create creates a comment together with its mentions
+func (r *taskCommentRepository) Create(comment *domain.TaskComment) error {
+	return r.db.Omit("Author").Create(comment).Error
+}
+
+// GetByID gets a comment by ID
+func (r *taskCommentRepository) GetByID(id uint) (*domain.TaskComment, error) {
+	var comment domain.TaskComment
+	err := r.db.Preload("Author").Preload("Mentions.User").First(&comment, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// GetByTaskID gets the discussion thread of a task, oldest first
+func (r *taskCommentRepository) GetByTaskID(taskID uint) ([]domain.TaskComment, error) {
+	var comments []domain.TaskComment
+	err := r.db.Preload("Author").Preload("Mentions.User").
+		Where("task_id = ?", taskID).
+		Order("created_at ASC, id ASC").
+		Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// GetByMentionedUser gets comments that mention a user, newest first
+func (r *taskCommentRepository) GetByMentionedUser(userID uint, page, limit int) ([]domain.TaskComment, int64, error) {
+	var comments []domain.TaskComment
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.db.Model(&domain.TaskComment{}).
+		Where("id IN (?)", r.db.Model(&domain.TaskCommentMention{}).Select("comment_id").Where("user_id = ?", userID)).
+		Session(&gorm.Session{})
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Author").Preload("Mentions.User").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// Update saves the comment body and replaces its mentions
+func (r *taskCommentRepository) Update(comment *domain.TaskComment, mentions []domain.TaskCommentMention) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Author", "Mentions").Save(comment).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id = ?", comment.ID).Delete(&domain.TaskCommentMention{}).Error; err != nil {
+			return err
+		}
+		for i := range mentions {
+			mentions[i].CommentID = comment.ID
+		}
+		if len(mentions) > 0 {
+			if err := tx.Omit("User").Create(&mentions).Error; err != nil {
+				return err
+			}
+		}
+		comment.Mentions = mentions
+		return nil
+	})
+}
+
+// Delete deletes a comment and its mentions
+func (r *taskCommentRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("comment_id = ?", id).Delete(&domain.TaskCommentMention{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.TaskComment{}, id).Error
+	})
+}
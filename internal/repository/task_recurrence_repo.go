@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type taskRecurrenceRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskRecurrenceRepository creates a new task recurrence repository
+func NewTaskRecurrenceRepository(db *gorm.DB) domain.TaskRecurrenceRepository {
+	return &taskRecurrenceRepository{db: db}
+}
+
+// Create creates a new recurrence rule
+func (r *taskRecurrenceRepository) Create(recurrence *domain.TaskRecurrence) error {
+	return r.db.Omit("Template").Create(recurrence).Error
+}
+
+// GetByID gets a recurrence rule by ID
+func (r *taskRecurrenceRepository) GetByID(id uint) (*domain.TaskRecurrence, error) {
+	var recurrence domain.TaskRecurrence
+	err := r.db.Preload("Template").First(&recurrence, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrRecurrenceNotFound
+		}
+		return nil, err
+	}
+	return &recurrence, nil
+}
+
+// GetByTemplateID gets all recurrence rules of a template
+func (r *taskRecurrenceRepository) GetByTemplateID(templateID uint) ([]domain.TaskRecurrence, error) {
+	var recurrences []domain.TaskRecurrence
+	err := r.db.Where("template_id = ?", templateID).Order("id ASC").Find(&recurrences).Error
+	if err != nil {
+		return nil, err
+	}
+	return recurrences, nil
+}
+
+// GetActive gets all active recurrence rules with their templates
+func (r *taskRecurrenceRepository) GetActive() ([]domain.TaskRecurrence, error) {
+	var recurrences []domain.TaskRecurrence
+	err := r.db.Preload("Template").Where("active = ?", true).Order("id ASC").Find(&recurrences).Error
+	if err != nil {
+		return nil, err
+	}
+	return recurrences, nil
+}
+
+// Update saves all fields of a recurrence rule
+func (r *taskRecurrenceRepository) Update(recurrence *domain.TaskRecurrence) error {
+	return r.db.Omit("Template").Save(recurrence).Error
+}
+
+// GetSkipped gets the skipped occurrences of a rule within [from, to]
+func (r *taskRecurrenceRepository) GetSkipped(recurrenceID uint, from, to time.Time) ([]domain.SkippedOccurrence, error) {
+	var skipped []domain.SkippedOccurrence
+	err := r.db.Where("recurrence_id = ? AND occurrence_date BETWEEN ? AND ?", recurrenceID, from, to).
+		Find(&skipped).Error
+	if err != nil {
+		return nil, err
+	}
+	return skipped, nil
+}
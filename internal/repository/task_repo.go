@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type taskRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskRepository creates a new task repository
+func NewTaskRepository(db *gorm.DB) domain.TaskRepository {
+	return &taskRepository{db: db}
+}
+
+// Create creates a new task
+func (r *taskRepository) Create(task *domain.Task) error {
+	return r.db.Omit(clause.Associations).Create(task).Error
+}
+
+// CreateBatch creates several tasks in a single transaction
+func (r *taskRepository) CreateBatch(tasks []domain.Task) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Omit(clause.Associations).Create(&tasks).Error
+	})
+}
+
+// CreateOccurrences inserts recurring task occurrences, skipping any that already exist.
+// It returns the number of tasks actually created.
+func (r *taskRepository) CreateOccurrences(tasks []domain.Task) (int64, error) {
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	result := r.db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&tasks)
+	return result.RowsAffected, result.Error
+}
+
+// GetByID gets a task by ID
+func (r *taskRepository) GetByID(id uint) (*domain.Task, error) {
+	var task domain.Task
+	err := r.db.Preload("Intern").Preload("AssignedBy").Preload("Subtasks").First(&task, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTaskNotFound
+		}
+		return nil, err
+	}
+	return &task, nil
+}
+
+// GetAll gets tasks matching the filter with pagination
+func (r *taskRepository) GetAll(filter domain.TaskFilter, page, limit int) ([]domain.Task, int64, error) {
+	var tasks []domain.Task
+	var total int64
+
+	offset := (page - 1) * limit
+	query := r.applyFilter(r.db.Model(&domain.Task{}), filter).Session(&gorm.Session{})
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated data
+	err := query.Preload("Intern").Preload("AssignedBy").
+		Order("deadline ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&tasks).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+// Update saves all fields of a task
+func (r *taskRepository) Update(task *domain.Task) error {
+	return r.db.Omit(clause.Associations).Save(task).Error
+}
+
+// Delete deletes a task by ID together with its history, grades, comments and attachment records,
+// returning the storage keys of the attachment files. Subtasks are detached rather than deleted,
+// and a deleted recurring occurrence is recorded as skipped.
+func (r *taskRepository) Delete(id uint) ([]string, error) {
+	var storageKeys []string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var task domain.Task
+		if err := tx.First(&task, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrTaskNotFound
+			}
+			return err
+		}
+		// Deleted occurrences stay deleted instead of being generated again
+		if task.RecurrenceID != nil && task.OccurrenceDate != nil {
+			skipped := &domain.SkippedOccurrence{
+				RecurrenceID:   *task.RecurrenceID,
+				InternID:       task.InternID,
+				OccurrenceDate: *task.OccurrenceDate,
+				CreatedAt:      time.Now(),
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(skipped).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&domain.Task{}).Where("parent_id = ?", id).Update("parent_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("task_id = ? OR blocked_by_id = ?", id, id).Delete(&domain.TaskDependency{}).Error; err != nil {
+			return err
+		}
+		commentIDs := tx.Model(&domain.TaskComment{}).Select("id").Where("task_id = ?", id)
+		if err := tx.Where("comment_id IN (?)", commentIDs).Delete(&domain.TaskCommentMention{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.TaskAttachment{}).Where("task_id = ?", id).Pluck("storage_key", &storageKeys).Error; err != nil {
+			return err
+		}
+		dependents := []interface{}{
+			&domain.TaskComment{},
+			&domain.TaskAttachment{},
+			&domain.TimeEntry{},
+			&domain.TaskGrade{},
+			&domain.TaskStatusHistory{},
+		}
+		for _, model := range dependents {
+			if err := tx.Where("task_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&domain.Task{}, id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return storageKeys, nil
+}
+
+// UpdateStatus saves the task and its history entry in one transaction
+func (r *taskRepository) UpdateStatus(task *domain.Task, history *domain.TaskStatusHistory) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(task).Error; err != nil {
+			return err
+		}
+		return tx.Create(history).Error
+	})
+}
+
+// GetStatusHistory gets the status transitions of a task, oldest first
+func (r *taskRepository) GetStatusHistory(taskID uint) ([]domain.TaskStatusHistory, error) {
+	var history []domain.TaskStatusHistory
+	err := r.db.Preload("ChangedBy").
+		Where("task_id = ?", taskID).
+		Order("created_at ASC, id ASC").
+		Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// SaveGrade stores the task's current grade and appends it to the grade history
+func (r *taskRepository) SaveGrade(task *domain.Task, grade *domain.TaskGrade) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(task).Error; err != nil {
+			return err
+		}
+		return tx.Create(grade).Error
+	})
+}
+
+// GetGrades gets every grade given to a task, oldest first
+func (r *taskRepository) GetGrades(taskID uint) ([]domain.TaskGrade, error) {
+	var grades []domain.TaskGrade
+	err := r.db.Preload("GradedBy").
+		Where("task_id = ?", taskID).
+		Order("created_at ASC, id ASC").
+		Find(&grades).Error
+	if err != nil {
+		return nil, err
+	}
+	return grades, nil
+}
+
+// GetSubtasks gets the direct children of a task
+func (r *taskRepository) GetSubtasks(parentID uint) ([]domain.Task, error) {
+	var tasks []domain.Task
+	err := r.db.Where("parent_id = ?", parentID).Order("deadline ASC, id ASC").Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// GetBlockers gets the tasks that block the given task
+func (r *taskRepository) GetBlockers(taskID uint) ([]domain.Task, error) {
+	var tasks []domain.Task
+	err := r.db.Where("id IN (?)",
+		r.db.Model(&domain.TaskDependency{}).Select("blocked_by_id").Where("task_id = ?", taskID)).
+		Order("id ASC").
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// GetBlocking gets the tasks blocked by the given task
+func (r *taskRepository) GetBlocking(taskID uint) ([]domain.Task, error) {
+	var tasks []domain.Task
+	err := r.db.Where("id IN (?)",
+		r.db.Model(&domain.TaskDependency{}).Select("task_id").Where("blocked_by_id = ?", taskID)).
+		Order("id ASC").
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// AddDependency creates a "blocked by" link; an existing identical link is left as is
+func (r *taskRepository) AddDependency(dependency *domain.TaskDependency) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(dependency).Error
+}
+
+// RemoveDependency deletes a "blocked by" link
+func (r *taskRepository) RemoveDependency(taskID, blockedByID uint) error {
+	return r.db.Where("task_id = ? AND blocked_by_id = ?", taskID, blockedByID).Delete(&domain.TaskDependency{}).Error
+}
+
+func (r *taskRepository) applyFilter(query *gorm.DB, filter domain.TaskFilter) *gorm.DB {
+	if filter.InternID != 0 {
+		query = query.Where("tasks.intern_id = ?", filter.InternID)
+	}
+	if filter.PICID != 0 {
+		query = query.Where("tasks.intern_id IN (?)",
+			r.db.Model(&domain.InternProfile{}).Select("user_id").Where("pic_id = ?", filter.PICID))
+	}
+	if filter.Status != "" {
+		query = query.Where("tasks.status = ?", filter.Status)
+	}
+	if filter.DeadlineFrom != nil {
+		query = query.Where("tasks.deadline >= ?", *filter.DeadlineFrom)
+	}
+	if filter.DeadlineTo != nil {
+		query = query.Where("tasks.deadline <= ?", *filter.DeadlineTo)
+	}
+	if filter.OverdueAt != nil {
+		query = query.Where("tasks.deadline < ? AND tasks.status IN ?", *filter.OverdueAt,
+			[]string{domain.TaskStatusTodo, domain.TaskStatusInProgress})
+	}
+	return query
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type taskTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskTemplateRepository creates a new task template repository
+func NewTaskTemplateRepository(db *gorm.DB) domain.TaskTemplateRepository {
+	return &taskTemplateRepository{db: db}
+}
+
+// Create creates a new task template
+func (r *taskTemplateRepository) Create(template *domain.TaskTemplate) error {
+	return r.db.Omit("CreatedBy").Create(template).Error
+}
+
+// GetByID gets a task template by ID
+func (r *taskTemplateRepository) GetByID(id uint) (*domain.TaskTemplate, error) {
+	var template domain.TaskTemplate
+	err := r.db.Preload("CreatedBy").First(&template, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTemplateNotFound
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+// GetAll gets all task templates with pagination
+func (r *taskTemplateRepository) GetAll(page, limit int) ([]domain.TaskTemplate, int64, error) {
+	var templates []domain.TaskTemplate
+	var total int64
+
+	offset := (page - 1) * limit
+
+	if err := r.db.Model(&domain.TaskTemplate{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.db.Preload("CreatedBy").
+		Order("title ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&templates).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
+
+// Update saves all fields of a task template
+func (r *taskTemplateRepository) Update(template *domain.TaskTemplate) error {
+	return r.db.Omit("CreatedBy").Save(template).Error
+}
+
+// Delete deletes a task template and its recurrence rules. Tasks created from it are kept.
+func (r *taskTemplateRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		recurrenceIDs := tx.Model(&domain.TaskRecurrence{}).Select("id").Where("template_id = ?", id)
+		if err := tx.Where("recurrence_id IN (?)", recurrenceIDs).Delete(&domain.SkippedOccurrence{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("template_id = ?", id).Delete(&domain.TaskRecurrence{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.TaskTemplate{}, id).Error
+	})
+}
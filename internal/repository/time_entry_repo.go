@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type timeEntryRepository struct {
+	db *gorm.DB
+}
+
+// NewTimeEntryRepository creates a new time entry repository
+func NewTimeEntryRepository(db *gorm.DB) domain.TimeEntryRepository {
+	return &timeEntryRepository{db: db}
+}
+
+// Create creates a new time entry
+func (r *timeEntryRepository) Create(entry *domain.TimeEntry) error {
+	return r.db.Omit(clause.Associations).Create(entry).Error
+}
+
+// GetByID gets a time entry by ID
+func (r *timeEntryRepository) GetByID(id uint) (*domain.TimeEntry, error) {
+	var entry domain.TimeEntry
+	err := r.db.Preload("Task").First(&entry, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetRunning gets the intern's running timer
+func (r *timeEntryRepository) GetRunning(internID uint) (*domain.TimeEntry, error) {
+	var entry domain.TimeEntry
+	err := r.db.Preload("Task").Where("intern_id = ? AND ended_at IS NULL", internID).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNoRunningTimer
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetByTaskID gets all time entries of a task, oldest first
+func (r *timeEntryRepository) GetByTaskID(taskID uint) ([]domain.TimeEntry, error) {
+	var entries []domain.TimeEntry
+	err := r.db.Where("task_id = ?", taskID).Order("started_at ASC, id ASC").Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetByInternAndPeriod gets an intern's entries started within [from, to)
+func (r *timeEntryRepository) GetByInternAndPeriod(internID uint, from, to time.Time) ([]domain.TimeEntry, error) {
+	var entries []domain.TimeEntry
+	err := r.db.Preload("Task").
+		Where("intern_id = ? AND started_at >= ? AND started_at < ?", internID, from, to).
+		Order("started_at ASC, id ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Update saves all fields of a time entry
+func (r *timeEntryRepository) Update(entry *domain.TimeEntry) error {
+	return r.db.Omit(clause.Associations).Save(entry).Error
+}
+
+// Delete deletes a time entry
+func (r *timeEntryRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.TimeEntry{}, id).Error
+}
+
+// ApprovePeriod approves every finished, pending entry of an intern started within [from, to)
+func (r *timeEntryRepository) ApprovePeriod(internID uint, from, to time.Time, reviewerID uint, reviewedAt time.Time) (int64, error) {
+	result := r.db.Model(&domain.TimeEntry{}).
+		Where("intern_id = ? AND started_at >= ? AND started_at < ?", internID, from, to).
+		Where("status = ? AND ended_at IS NOT NULL", domain.TimeEntryPending).
+		Updates(map[string]interface{}{
+			"status":         domain.TimeEntryApproved,
+			"reviewed_by_id": reviewerID,
+			"reviewed_at":    reviewedAt,
+			"updated_at":     reviewedAt,
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"errors"
+
+	"backend-dashboard/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type workScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkScheduleRepository creates a new work schedule repository
+func NewWorkScheduleRepository(db *gorm.DB) domain.WorkScheduleRepository {
+	return &workScheduleRepository{db: db}
+}
+
+// Create creates a new work schedule
+func (r *workScheduleRepository) Create(schedule *domain.WorkSchedule) error {
+	return r.db.Create(schedule).Error
+}
+
+// GetByID gets a work schedule by ID
+func (r *workScheduleRepository) GetByID(id uint) (*domain.WorkSchedule, error) {
+	var schedule domain.WorkSchedule
+	err := r.db.First(&schedule, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWorkScheduleNotFound
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// GetByCohort gets the schedule defined for exactly this division and batch
+func (r *workScheduleRepository) GetByCohort(division, batch string) (*domain.WorkSchedule, error) {
+	var schedule domain.WorkSchedule
+	err := r.db.Where("division = ? AND batch = ?", division, batch).First(&schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWorkScheduleNotFound
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// FindFor gets the most specific schedule that applies to an intern of the division and batch:
+// division and batch, then division only, then batch only, then the default
+func (r *workScheduleRepository) FindFor(division, batch string) (*domain.WorkSchedule, error) {
+	var schedule domain.WorkSchedule
+	err := r.db.
+		Where("division IN ?", []string{division, ""}).
+		Where("batch IN ?", []string{batch, ""}).
+		Order("division <> '' DESC, batch <> '' DESC").
+		First(&schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWorkScheduleNotFound
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// GetAll gets all work schedules
+func (r *workScheduleRepository) GetAll() ([]domain.WorkSchedule, error) {
+	var schedules []domain.WorkSchedule
+	err := r.db.Order("division ASC, batch ASC").Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// Update updates a work schedule
+func (r *workScheduleRepository) Update(schedule *domain.WorkSchedule) error {
+	return r.db.Save(schedule).Error
+}
+
+// Delete deletes a work schedule
+func (r *workScheduleRepository) Delete(id uint) error {
+	return r.db.Delete(&domain.WorkSchedule{}, id).Error
+}
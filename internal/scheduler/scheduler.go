@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job is a piece of background work run periodically inside the API process.
+// Jobs must be idempotent: they run once at startup and then on every tick.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(now time.Time) error
+}
+
+// Start launches every job in its own goroutine until ctx is cancelled
+func Start(ctx context.Context, jobs ...Job) {
+	for _, job := range jobs {
+		go run(ctx, job)
+	}
+}
+
+func run(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	execute(job, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			execute(job, now)
+		}
+	}
+}
+
+// execute runs a job once, logging failures and recovering from panics so one bad run
+// does not take the API down
+func execute(job Job, now time.Time) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Job %s panicked: %v", job.Name, r)
+		}
+	}()
+
+	if err := job.Run(now); err != nil {
+		log.Printf("Job %s failed: %v", job.Name, err)
+	}
+}
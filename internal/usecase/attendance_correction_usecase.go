@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"encoding/json"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+type attendanceCorrectionUsecase struct {
+	correctionRepo domain.AttendanceCorrectionRepository
+	attendanceRepo domain.AttendanceRepository
+	scheduleRepo   domain.WorkScheduleRepository
+	holidayRepo    domain.HolidayRepository
+	leaveRepo      domain.LeaveRequestRepository
+	internRepo     domain.InternRepository
+}
+
+// NewAttendanceCorrectionUsecase creates a new attendance correction usecase
+func NewAttendanceCorrectionUsecase(correctionRepo domain.AttendanceCorrectionRepository, attendanceRepo domain.AttendanceRepository, scheduleRepo domain.WorkScheduleRepository, holidayRepo domain.HolidayRepository, leaveRepo domain.LeaveRequestRepository, internRepo domain.InternRepository) domain.AttendanceCorrectionUsecase {
+	return &attendanceCorrectionUsecase{
+		correctionRepo: correctionRepo,
+		attendanceRepo: attendanceRepo,
+		scheduleRepo:   scheduleRepo,
+		holidayRepo:    holidayRepo,
+		leaveRepo:      leaveRepo,
+		internRepo:     internRepo,
+	}
+}
+
+// attendanceSnapshot is the part of an attendance record a correction changes, as written to the audit log
+type attendanceSnapshot struct {
+	Status       string     `json:"status"`
+	CheckInTime  *time.Time `json:"check_in_time"`
+	CheckOutTime *time.Time `json:"check_out_time"`
+	LateMinutes  int        `json:"late_minutes"`
+}
+
+// RequestCorrection lets an intern propose the attendance of a past working day within their
+// internship that is not covered by approved leave. Hadir and terlambat need a check-in and
+// allow a check-out, both on that day, and must agree with the work schedule; izin takes no times.
+func (u *attendanceCorrectionUsecase) RequestCorrection(actorID, actorRoleID uint, date time.Time, status string, checkIn, checkOut *time.Time, reason string) (*domain.AttendanceCorrection, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+	if reason == "" {
+		return nil, domain.ErrReasonRequired
+	}
+	switch status {
+	case domain.AttendanceHadir, domain.AttendanceTerlambat:
+		if checkIn == nil {
+			return nil, domain.ErrInvalidCorrection
+		}
+	case domain.AttendanceIzin:
+		if checkIn != nil || checkOut != nil {
+			return nil, domain.ErrInvalidCorrection
+		}
+	default:
+		return nil, domain.ErrInvalidCorrection
+	}
+
+	now := time.Now()
+	date = startOfDay(date)
+	if !date.Before(startOfDay(now)) {
+		return nil, domain.ErrInvalidCorrectionDate
+	}
+
+	profile, err := u.internRepo.GetByUserID(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if date.Before(startOfDay(profile.StartDate)) || date.After(startOfDay(profile.EndDate)) {
+		return nil, domain.ErrOutsideInternshipPeriod
+	}
+
+	if checkIn != nil && !startOfDay(*checkIn).Equal(date) {
+		return nil, domain.ErrInvalidCorrection
+	}
+	if checkOut != nil && (!checkOut.After(*checkIn) || !startOfDay(*checkOut).Equal(date)) {
+		return nil, domain.ErrInvalidCorrection
+	}
+
+	if err := u.checkNotOnLeave(actorID, date); err != nil {
+		return nil, err
+	}
+
+	derived, _, err := u.correctedStatus(profile, date, status, checkIn)
+	if err != nil {
+		return nil, err
+	}
+	if derived != status {
+		return nil, domain.ErrCorrectionMismatch
+	}
+
+	if _, err := u.correctionRepo.GetPendingForDay(actorID, date); err == nil {
+		return nil, domain.ErrCorrectionExists
+	} else if err != domain.ErrCorrectionNotFound {
+		return nil, err
+	}
+
+	correction := &domain.AttendanceCorrection{
+		InternID:             actorID,
+		Date:                 date,
+		ProposedStatus:       status,
+		ProposedCheckInTime:  checkIn,
+		ProposedCheckOutTime: checkOut,
+		Reason:               reason,
+		Status:               domain.CorrectionPending,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	attendance, err := u.attendanceRepo.GetByInternAndDate(actorID, date)
+	if err != nil && err != domain.ErrAttendanceNotFound {
+		return nil, err
+	}
+	if attendance != nil {
+		captureOriginal(correction, attendance)
+	}
+
+	if err := u.correctionRepo.Create(correction); err != nil {
+		return nil, err
+	}
+
+	return u.correctionRepo.GetByID(correction.ID)
+}
+
+// GetCorrections lists corrections visible to the actor.
+// Interns only see their own, PICs see their interns, HR sees everything.
+func (u *attendanceCorrectionUsecase) GetCorrections(actorID, actorRoleID uint, filter domain.AttendanceCorrectionFilter, page, limit int) ([]domain.AttendanceCorrection, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		// HR sees everything
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+	case actorRoleID == domain.RoleIntern:
+		filter.InternID = actorID
+	default:
+		return nil, 0, domain.ErrForbidden
+	}
+
+	return u.correctionRepo.GetAll(filter, page, limit)
+}
+
+// GetCorrectionByID gets a correction visible to the actor
+func (u *attendanceCorrectionUsecase) GetCorrectionByID(actorID, actorRoleID, id uint) (*domain.AttendanceCorrection, error) {
+	correction, err := u.correctionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorRoleID == domain.RoleIntern {
+		if correction.InternID != actorID {
+			return nil, domain.ErrForbidden
+		}
+		return correction, nil
+	}
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, correction.InternID); err != nil {
+		return nil, err
+	}
+
+	return correction, nil
+}
+
+// ApproveCorrection applies the proposed values to the day's attendance record, creating it
+// when missing, and writes an audit entry with the values before (null without a record) and after.
+// Whether a check-in was late is derived again from the work schedule and holidays in effect now,
+// and leave approved since the request blocks it.
+func (u *attendanceCorrectionUsecase) ApproveCorrection(actorID, actorRoleID, id uint) (*domain.AttendanceCorrection, error) {
+	correction, err := u.pendingCorrection(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkNotOnLeave(correction.InternID, correction.Date); err != nil {
+		return nil, err
+	}
+
+	profile, err := u.internRepo.GetByUserID(correction.InternID)
+	if err != nil {
+		return nil, err
+	}
+	status, lateMinutes, err := u.correctedStatus(profile, correction.Date, correction.ProposedStatus, correction.ProposedCheckInTime)
+	if err != nil {
+		return nil, err
+	}
+	correction.ProposedStatus = status
+
+	now := time.Now()
+	attendance, err := u.attendanceRepo.GetByInternAndDate(correction.InternID, correction.Date)
+	if err == domain.ErrAttendanceNotFound {
+		attendance = &domain.Attendance{
+			InternID:  correction.InternID,
+			Date:      correction.Date,
+			CreatedAt: now,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The record may have changed since the request, e.g. marked alpha by the daily job
+	correction.AttendanceID = nil
+	correction.OriginalStatus = ""
+	correction.OriginalCheckInTime = nil
+	correction.OriginalCheckOutTime = nil
+	var before *attendanceSnapshot
+	if attendance.ID != 0 {
+		captureOriginal(correction, attendance)
+		before = snapshotAttendance(attendance)
+	}
+
+	attendance.Status = status
+	attendance.CheckInTime = correction.ProposedCheckInTime
+	attendance.LateMinutes = lateMinutes
+	attendance.CheckOutTime = correction.ProposedCheckOutTime
+	attendance.WorkedMinutes = 0
+	if attendance.CheckInTime != nil && attendance.CheckOutTime != nil {
+		attendance.WorkedMinutes = int(attendance.CheckOutTime.Sub(*attendance.CheckInTime).Minutes())
+	}
+	attendance.UpdatedAt = now
+
+	details, err := json.Marshal(map[string]interface{}{
+		"correction_id": correction.ID,
+		"before":        before,
+		"after":         snapshotAttendance(attendance),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	correction.Status = domain.CorrectionApproved
+	correction.ReviewedByID = &actorID
+	correction.ReviewedAt = &now
+	correction.UpdatedAt = now
+
+	audit := &domain.AuditLog{
+		UserID:     actorID,
+		Action:     "updated",
+		EntityType: "attendance",
+		Details:    string(details),
+		CreatedAt:  now,
+	}
+
+	if err := u.correctionRepo.Approve(correction, attendance, audit); err != nil {
+		return nil, err
+	}
+
+	return u.correctionRepo.GetByID(correction.ID)
+}
+
+// RejectCorrection rejects a pending correction with a reason
+func (u *attendanceCorrectionUsecase) RejectCorrection(actorID, actorRoleID, id uint, reason string) (*domain.AttendanceCorrection, error) {
+	if reason == "" {
+		return nil, domain.ErrReasonRequired
+	}
+
+	correction, err := u.pendingCorrection(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	correction.Status = domain.CorrectionRejected
+	correction.RejectionReason = reason
+	correction.ReviewedByID = &actorID
+	correction.ReviewedAt = &now
+	correction.UpdatedAt = now
+
+	if err := u.correctionRepo.Update(correction); err != nil {
+		return nil, err
+	}
+
+	return u.correctionRepo.GetByID(correction.ID)
+}
+
+// CancelCorrection lets an intern withdraw their own pending correction
+func (u *attendanceCorrectionUsecase) CancelCorrection(actorID, actorRoleID, id uint) error {
+	correction, err := u.correctionRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if actorRoleID != domain.RoleIntern || correction.InternID != actorID {
+		return domain.ErrForbidden
+	}
+	if correction.Status != domain.CorrectionPending {
+		return domain.ErrCorrectionNotPending
+	}
+
+	return u.correctionRepo.Delete(correction.ID)
+}
+
+// pendingCorrection loads a correction the actor may review and checks it is still pending
+func (u *attendanceCorrectionUsecase) pendingCorrection(actorID, actorRoleID, id uint) (*domain.AttendanceCorrection, error) {
+	correction, err := u.correctionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, correction.InternID); err != nil {
+		return nil, err
+	}
+	if correction.Status != domain.CorrectionPending {
+		return nil, domain.ErrCorrectionNotPending
+	}
+
+	return correction, nil
+}
+
+// correctedStatus returns the status and late minutes a correction writes. Izin is kept as
+// proposed; with a check-in, hadir or terlambat is derived the way CheckIn would have.
+// Days that are not working days of the intern cannot be corrected.
+func (u *attendanceCorrectionUsecase) correctedStatus(profile *domain.InternProfile, date time.Time, status string, checkIn *time.Time) (string, int, error) {
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, date, date)
+	if err != nil {
+		return "", 0, err
+	}
+	schedule, err := calendar.schedule(profile.Division, profile.Batch)
+	if err != nil {
+		return "", 0, err
+	}
+	if !calendar.isWorkingDay(schedule, startOfDay(date)) {
+		return "", 0, domain.ErrInvalidCorrectionDate
+	}
+	if checkIn == nil {
+		return status, 0, nil
+	}
+
+	// Without a schedule there is no start time to be late for
+	if schedule != nil {
+		if late := schedule.LateMinutes(*checkIn); late > 0 {
+			return domain.AttendanceTerlambat, late, nil
+		}
+	}
+	return domain.AttendanceHadir, 0, nil
+}
+
+// checkNotOnLeave rejects days covered by approved leave, whose izin record belongs to the
+// leave request rather than to a correction
+func (u *attendanceCorrectionUsecase) checkNotOnLeave(internID uint, date time.Time) error {
+	leaves, err := u.leaveRepo.GetOverlapping(internID, date, date)
+	if err != nil {
+		return err
+	}
+	for _, leave := range leaves {
+		if leave.Status == domain.LeaveApproved {
+			return domain.ErrCorrectionOnLeave
+		}
+	}
+	return nil
+}
+
+// captureOriginal copies the values a correction replaces from the existing record
+func captureOriginal(correction *domain.AttendanceCorrection, attendance *domain.Attendance) {
+	correction.AttendanceID = &attendance.ID
+	correction.OriginalStatus = attendance.Status
+	correction.OriginalCheckInTime = attendance.CheckInTime
+	correction.OriginalCheckOutTime = attendance.CheckOutTime
+}
+
+// snapshotAttendance returns the fields of a record a correction changes
+func snapshotAttendance(attendance *domain.Attendance) *attendanceSnapshot {
+	return &attendanceSnapshot{
+		Status:       attendance.Status,
+		CheckInTime:  attendance.CheckInTime,
+		CheckOutTime: attendance.CheckOutTime,
+		LateMinutes:  attendance.LateMinutes,
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+func TestCorrectedStatus(t *testing.T) {
+	// 2026-03-02 is a Monday; Wednesday 2026-03-04 is a holiday
+	holidays := []domain.Holiday{{Date: date(2026, 3, 4), Name: "Hari Raya Nyepi"}}
+	schedules := []domain.WorkSchedule{
+		{Division: "Engineering", WorkingDays: "1,2,3,4,5", StartTime: "09:00", EndTime: "17:00", GracePeriodMinutes: 10},
+	}
+	at := func(day time.Time, hour, minute int) *time.Time {
+		t := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+		return &t
+	}
+
+	tests := []struct {
+		name     string
+		division string
+		day      time.Time
+		status   string
+		checkIn  *time.Time
+		want     string
+		wantLate int
+		wantErr  error
+	}{
+		{"on time", "Engineering", date(2026, 3, 2), domain.AttendanceHadir, at(date(2026, 3, 2), 8, 55), domain.AttendanceHadir, 0, nil},
+		{"within the grace period", "Engineering", date(2026, 3, 2), domain.AttendanceHadir, at(date(2026, 3, 2), 9, 10), domain.AttendanceHadir, 0, nil},
+		{"late", "Engineering", date(2026, 3, 2), domain.AttendanceHadir, at(date(2026, 3, 2), 9, 45), domain.AttendanceTerlambat, 45, nil},
+		{"izin keeps the proposed status", "Engineering", date(2026, 3, 3), domain.AttendanceIzin, nil, domain.AttendanceIzin, 0, nil},
+		{"no schedule is never late", "Finance", date(2026, 3, 2), domain.AttendanceTerlambat, at(date(2026, 3, 2), 11, 0), domain.AttendanceHadir, 0, nil},
+		{"holiday", "Engineering", date(2026, 3, 4), domain.AttendanceHadir, at(date(2026, 3, 4), 9, 0), "", 0, domain.ErrInvalidCorrectionDate},
+		{"weekend", "Engineering", date(2026, 3, 7), domain.AttendanceIzin, nil, "", 0, domain.ErrInvalidCorrectionDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &attendanceCorrectionUsecase{
+				scheduleRepo: &fakeScheduleRepo{schedules: schedules},
+				holidayRepo:  &fakeHolidayRepo{holidays: holidays},
+			}
+			profile := &domain.InternProfile{Division: tt.division, Batch: "2026A"}
+
+			status, late, err := u.correctedStatus(profile, tt.day, tt.status, tt.checkIn)
+			if err != tt.wantErr {
+				t.Fatalf("correctedStatus() error = %v, want %v", err, tt.wantErr)
+			}
+			if status != tt.want || late != tt.wantLate {
+				t.Errorf("correctedStatus() = %q, %d, want %q, %d", status, late, tt.want, tt.wantLate)
+			}
+		})
+	}
+}
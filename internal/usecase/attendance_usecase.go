@@ -0,0 +1,536 @@
+package usecase
+
+import (
+	"log"
+	"time"
+
+	"backend-dashboard/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// kioskTokenWindow is how often the kiosk check-in token rotates. A token is accepted
+// until the end of the window after the one it was issued in, to allow for scanning.
+const kioskTokenWindow = 30 * time.Second
+
+// kioskPurpose identifies kiosk check-in tokens and derives their signing key
+const kioskPurpose = "attendance_kiosk"
+
+type attendanceUsecase struct {
+	attendanceRepo domain.AttendanceRepository
+	scheduleRepo   domain.WorkScheduleRepository
+	holidayRepo    domain.HolidayRepository
+	internRepo     domain.InternRepository
+	leaveRepo      domain.LeaveRequestRepository
+	locationRepo   domain.OfficeLocationRepository
+	jwtSecret      string
+}
+
+// NewAttendanceUsecase creates a new attendance usecase
+func NewAttendanceUsecase(attendanceRepo domain.AttendanceRepository, scheduleRepo domain.WorkScheduleRepository, holidayRepo domain.HolidayRepository, internRepo domain.InternRepository, leaveRepo domain.LeaveRequestRepository, locationRepo domain.OfficeLocationRepository, jwtSecret string) domain.AttendanceUsecase {
+	return &attendanceUsecase{
+		attendanceRepo: attendanceRepo,
+		scheduleRepo:   scheduleRepo,
+		holidayRepo:    holidayRepo,
+		internRepo:     internRepo,
+		leaveRepo:      leaveRepo,
+		locationRepo:   locationRepo,
+		jwtSecret:      jwtSecret,
+	}
+}
+
+// CreateKioskToken issues the check-in token for the current window, shown on the
+// office kiosk as a QR code
+func (u *attendanceUsecase) CreateKioskToken(now time.Time) (string, time.Time, error) {
+	windowStart := now.Truncate(kioskTokenWindow)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"purpose": kioskPurpose,
+		"iat":     windowStart.Unix(),
+		"exp":     windowStart.Add(2 * kioskTokenWindow).Unix(),
+	})
+
+	tokenString, err := token.SignedString(purposeKey(u.jwtSecret, kioskPurpose))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, windowStart.Add(kioskTokenWindow), nil
+}
+
+// CheckIn records today's check-in for the acting intern using the server clock.
+// The intern must present a fresh token scanned from the office kiosk, or, in divisions
+// with a hybrid office location, check in from its network or within its geofence.
+// Check-ins after the grace period of the intern's work schedule are marked terlambat.
+func (u *attendanceUsecase) CheckIn(actorID, actorRoleID uint, input domain.CheckInInput) (*domain.Attendance, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	now := time.Now()
+	today := startOfDay(now)
+
+	profile, err := u.internRepo.GetByUserID(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if today.Before(startOfDay(profile.StartDate)) || today.After(startOfDay(profile.EndDate)) {
+		return nil, domain.ErrOutsideInternshipPeriod
+	}
+
+	remote, err := u.verifyCheckInLocation(profile.Division, input, now)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance := &domain.Attendance{
+		InternID:    actorID,
+		Date:        today,
+		Status:      domain.AttendanceHadir,
+		CheckInTime: &now,
+		ClientIP:    input.ClientIP,
+		Latitude:    input.Latitude,
+		Longitude:   input.Longitude,
+		IsRemote:    remote,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	// Without a schedule there is no start time to be late for
+	schedule, err := u.scheduleRepo.FindFor(profile.Division, profile.Batch)
+	if err != nil && err != domain.ErrWorkScheduleNotFound {
+		return nil, err
+	}
+	if schedule != nil {
+		if late := schedule.LateMinutes(now); late > 0 {
+			attendance.Status = domain.AttendanceTerlambat
+			attendance.LateMinutes = late
+		}
+	}
+
+	if err := u.attendanceRepo.Create(attendance); err != nil {
+		return nil, err
+	}
+
+	return attendance, nil
+}
+
+// verifyCheckInLocation decides whether a check-in may happen and reports whether it
+// should be flagged as remote. A valid kiosk token is required, and the office locations of
+// the intern's division are an extra check on where it was scanned. Only divisions with a
+// hybrid office location may check in without a token, matched against those locations alone.
+func (u *attendanceUsecase) verifyCheckInLocation(division string, input domain.CheckInInput, now time.Time) (bool, error) {
+	locations, err := u.locationRepo.GetForDivision(division)
+	if err != nil {
+		return false, err
+	}
+
+	if input.KioskToken != "" {
+		if err := u.verifyKioskToken(input.KioskToken, now); err != nil {
+			return false, err
+		}
+		if len(locations) == 0 {
+			return false, nil
+		}
+		return matchOfficeLocations(locations, input)
+	}
+
+	// Shared locations never count: hybrid check-in is opted into per division
+	var hybrid []domain.OfficeLocation
+	for _, location := range locations {
+		if location.Hybrid && location.Division == division {
+			hybrid = append(hybrid, location)
+		}
+	}
+	if len(hybrid) == 0 {
+		return false, domain.ErrInvalidKioskToken
+	}
+	return matchOfficeLocations(hybrid, input)
+}
+
+// matchOfficeLocations accepts a check-in inside any of the locations. Outside all of them
+// it is rejected if any location enforces it, and flagged as remote if not.
+func matchOfficeLocations(locations []domain.OfficeLocation, input domain.CheckInInput) (bool, error) {
+	for i := range locations {
+		if locations[i].Contains(input.ClientIP, input.Latitude, input.Longitude) {
+			return false, nil
+		}
+	}
+	for _, location := range locations {
+		if location.Enforcement == domain.EnforcementReject {
+			return false, domain.ErrOutsideOffice
+		}
+	}
+	return true, nil
+}
+
+// verifyKioskToken checks the signature, purpose and freshness of a kiosk token
+func (u *attendanceUsecase) verifyKioskToken(tokenString string, now time.Time) error {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return purposeKey(u.jwtSecret, kioskPurpose), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithTimeFunc(func() time.Time { return now }))
+	if err != nil || !token.Valid || claims["purpose"] != kioskPurpose {
+		return domain.ErrInvalidKioskToken
+	}
+	return nil
+}
+
+// CheckOut records today's check-out for the acting intern and the minutes worked
+func (u *attendanceUsecase) CheckOut(actorID, actorRoleID uint) (*domain.Attendance, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	now := time.Now()
+	attendance, err := u.attendanceRepo.GetByInternAndDate(actorID, startOfDay(now))
+	if err != nil {
+		if err == domain.ErrAttendanceNotFound {
+			return nil, domain.ErrNotCheckedIn
+		}
+		return nil, err
+	}
+
+	if attendance.CheckInTime == nil {
+		return nil, domain.ErrNotCheckedIn
+	}
+	if attendance.CheckOutTime != nil {
+		return nil, domain.ErrAlreadyCheckedOut
+	}
+
+	attendance.CheckOutTime = &now
+	attendance.WorkedMinutes = int(now.Sub(*attendance.CheckInTime).Minutes())
+	attendance.UpdatedAt = now
+
+	if err := u.attendanceRepo.Update(attendance); err != nil {
+		return nil, err
+	}
+
+	return attendance, nil
+}
+
+// GetToday gets the acting intern's record for today
+func (u *attendanceUsecase) GetToday(actorID, actorRoleID uint) (*domain.Attendance, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	return u.attendanceRepo.GetByInternAndDate(actorID, startOfDay(time.Now()))
+}
+
+// GetAttendances lists attendance records visible to the actor.
+// Interns only see their own, PICs see their interns, HR sees everything.
+func (u *attendanceUsecase) GetAttendances(actorID, actorRoleID uint, filter domain.AttendanceFilter, page, limit int) ([]domain.Attendance, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		// HR sees everything
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+	case actorRoleID == domain.RoleIntern:
+		filter.InternID = actorID
+	default:
+		return nil, 0, domain.ErrForbidden
+	}
+
+	return u.attendanceRepo.GetAll(filter, page, limit)
+}
+
+// GetAttendanceRate summarises an intern's attendance over the working days in [from, to].
+// Days outside the internship, holidays and days still to come are not counted.
+func (u *attendanceUsecase) GetAttendanceRate(actorID, actorRoleID, internID uint, from, to time.Time) (*domain.AttendanceRate, error) {
+	if actorRoleID == domain.RoleIntern {
+		if internID != actorID {
+			return nil, domain.ErrForbidden
+		}
+	} else if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return nil, err
+	}
+
+	profile, err := u.internRepo.GetByUserID(internID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to = startOfDay(from), startOfDay(to)
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, from, to)
+	if err != nil {
+		return nil, err
+	}
+	records, err := u.attendanceRepo.GetByInternBetween(internID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizeAttendance(calendar, profile, records, from, to, time.Now())
+}
+
+// GetCalendar returns one entry per calendar day of a YYYY-MM period for an intern,
+// including days without a record: holidays, days off, leave and days not marked yet
+func (u *attendanceUsecase) GetCalendar(actorID, actorRoleID, internID uint, period string) ([]domain.AttendanceDay, error) {
+	if actorRoleID == domain.RoleIntern {
+		if internID != actorID {
+			return nil, domain.ErrForbidden
+		}
+	} else if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return nil, err
+	}
+
+	month, err := time.ParseInLocation("2006-01", period, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	from, to := month, month.AddDate(0, 1, -1)
+
+	profile, err := u.internRepo.GetByUserID(internID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, from, to)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := calendar.schedule(profile.Division, profile.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := u.attendanceRepo.GetByInternBetween(internID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	byDay := make(map[string]*domain.Attendance, len(records))
+	for i := range records {
+		byDay[records[i].Date.Format("2006-01-02")] = &records[i]
+	}
+
+	leaves, err := u.leaveRepo.GetOverlapping(internID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	internshipStart, internshipEnd := startOfDay(profile.StartDate), startOfDay(profile.EndDate)
+
+	var days []domain.AttendanceDay
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		entry := domain.AttendanceDay{
+			Date:        day,
+			WorkingDay:  calendar.isWorkingDay(schedule, day),
+			HolidayName: calendar.holidayName(day),
+		}
+
+		for i := range leaves {
+			if !day.Before(startOfDay(leaves[i].StartDate)) && !day.After(startOfDay(leaves[i].EndDate)) {
+				entry.LeaveRequestID = &leaves[i].ID
+				entry.LeaveStatus = leaves[i].Status
+				break
+			}
+		}
+
+		record, ok := byDay[day.Format("2006-01-02")]
+		switch {
+		case ok:
+			entry.Status = record.Status
+			entry.CheckInTime = record.CheckInTime
+			entry.CheckOutTime = record.CheckOutTime
+			entry.LateMinutes = record.LateMinutes
+			entry.IsRemote = record.IsRemote
+		case day.Before(internshipStart) || day.After(internshipEnd):
+			entry.Status = domain.CalendarOutsideInternship
+		case calendar.isHoliday(day):
+			entry.Status = domain.CalendarHoliday
+		case !entry.WorkingDay:
+			entry.Status = domain.CalendarDayOff
+		case entry.LeaveStatus == domain.LeaveApproved:
+			entry.Status = domain.AttendanceIzin
+		case now.Before(workdayEnd(schedule, day)):
+			entry.Status = domain.CalendarUpcoming
+		default:
+			entry.Status = domain.CalendarMissing
+		}
+
+		days = append(days, entry)
+	}
+
+	return days, nil
+}
+
+// GetMonthlyRecap summarises the attendance of every intern matching the filter over a
+// YYYY-MM period. PICs only get their own interns; interns without a day of internship
+// in the period are left out.
+func (u *attendanceUsecase) GetMonthlyRecap(actorID, actorRoleID uint, period string, filter domain.AttendanceRecapFilter) ([]domain.AttendanceRecap, error) {
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		// HR sees everything
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+	default:
+		return nil, domain.ErrForbidden
+	}
+
+	month, err := time.ParseInLocation("2006-01", period, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	from, to := month, month.AddDate(0, 1, -1)
+
+	profiles, err := u.internRepo.FindByCohort(filter.Batch, filter.Division, filter.PICID)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []domain.InternProfile
+	var internIDs []uint
+	for _, profile := range profiles {
+		if startOfDay(profile.StartDate).After(to) || startOfDay(profile.EndDate).Before(from) {
+			continue
+		}
+		active = append(active, profile)
+		internIDs = append(internIDs, profile.UserID)
+	}
+
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, from, to)
+	if err != nil {
+		return nil, err
+	}
+	records, err := u.attendanceRepo.GetByInternsBetween(internIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	byIntern := make(map[uint][]domain.Attendance)
+	for _, record := range records {
+		byIntern[record.InternID] = append(byIntern[record.InternID], record)
+	}
+
+	now := time.Now()
+	recaps := make([]domain.AttendanceRecap, 0, len(active))
+	for i := range active {
+		profile := &active[i]
+		rate, err := summarizeAttendance(calendar, profile, byIntern[profile.UserID], from, to, now)
+		if err != nil {
+			return nil, err
+		}
+
+		recaps = append(recaps, domain.AttendanceRecap{
+			AttendanceRate: *rate,
+			FullName:       profile.User.FullName,
+			Batch:          profile.Batch,
+			Division:       profile.Division,
+			PICID:          profile.PICID,
+			Period:         period,
+		})
+	}
+
+	return recaps, nil
+}
+
+// summarizeAttendance counts an intern's records over the working days in [from, to].
+// Days outside the internship, holidays and days still to come are not counted.
+// The calendar must cover [from, to].
+func summarizeAttendance(calendar *workCalendar, profile *domain.InternProfile, records []domain.Attendance, from, to, now time.Time) (*domain.AttendanceRate, error) {
+	rate := &domain.AttendanceRate{InternID: profile.UserID, From: from, To: to}
+
+	first := latestOf(from, startOfDay(profile.StartDate))
+	last := earliestOf(to, startOfDay(profile.EndDate), startOfDay(now))
+	if last.Before(first) {
+		return rate, nil
+	}
+
+	days, err := calendar.workingDays(profile.Division, profile.Batch, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	byDay := make(map[string]*domain.Attendance, len(records))
+	for i := range records {
+		byDay[records[i].Date.Format("2006-01-02")] = &records[i]
+	}
+
+	rate.WorkingDays = len(days)
+	for _, day := range days {
+		record, ok := byDay[day.Format("2006-01-02")]
+		switch {
+		case !ok:
+			// Not marked yet (today before office hours end)
+		case record.IsPresent():
+			rate.Present++
+			if record.Status == domain.AttendanceTerlambat {
+				rate.Late++
+			}
+		case record.Status == domain.AttendanceIzin:
+			rate.Izin++
+		case record.Status == domain.AttendanceAlpha:
+			rate.Alpha++
+		}
+	}
+
+	if expected := rate.WorkingDays - rate.Izin; expected > 0 {
+		rate.Rate = float64(rate.Present) / float64(expected) * 100
+	}
+
+	return rate, nil
+}
+
+// MarkAlpha records alpha for yesterday and, once office hours are over, today.
+// It runs as a background job; covering yesterday catches up after downtime.
+func (u *attendanceUsecase) MarkAlpha(now time.Time) error {
+	today := startOfDay(now)
+	created, err := u.MarkAlphaRange(today.AddDate(0, 0, -1), today, now)
+	if created > 0 {
+		log.Printf("Attendance: marked %d intern(s) alpha", created)
+	}
+	return err
+}
+
+// MarkAlphaRange creates an alpha record for every active intern without a record on a
+// working day in [from, to] whose office hours ended before now. Holidays are skipped. Existing records
+// (check-ins, approved leave, earlier runs) are kept, so the range can be re-run safely.
+func (u *attendanceUsecase) MarkAlphaRange(from, to, now time.Time) (int64, error) {
+	from, to = startOfDay(from), startOfDay(to)
+	if to.Before(from) {
+		return 0, nil
+	}
+
+	profiles, err := u.internRepo.GetActiveBetween(from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	var records []domain.Attendance
+	for _, profile := range profiles {
+		schedule, err := calendar.schedule(profile.Division, profile.Batch)
+		if err != nil {
+			return 0, err
+		}
+
+		first := latestOf(from, startOfDay(profile.StartDate))
+		last := earliestOf(to, startOfDay(profile.EndDate))
+		for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+			if !calendar.isWorkingDay(schedule, day) || now.Before(workdayEnd(schedule, day)) {
+				continue
+			}
+
+			records = append(records, domain.Attendance{
+				InternID:  profile.UserID,
+				Date:      day,
+				Status:    domain.AttendanceAlpha,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+	}
+
+	return u.attendanceRepo.CreateMissing(records)
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+func TestVerifyCheckInLocation(t *testing.T) {
+	const officeIP, homeIP = "203.0.113.42", "192.0.2.1"
+	locations := []domain.OfficeLocation{
+		{Division: "Engineering", CIDRs: "203.0.113.0/24", Enforcement: domain.EnforcementReject, Hybrid: true},
+		{Division: "Design", CIDRs: "203.0.113.0/24", Enforcement: domain.EnforcementFlag},
+		{Division: "", CIDRs: "198.51.100.0/24", Enforcement: domain.EnforcementFlag, Hybrid: true},
+	}
+
+	now := time.Date(2026, 3, 2, 8, 30, 0, 0, time.Local)
+	u := &attendanceUsecase{locationRepo: &fakeLocationRepo{locations: locations}, jwtSecret: "secret"}
+	token, _, err := u.CreateKioskToken(now)
+	if err != nil {
+		t.Fatalf("CreateKioskToken() error = %v", err)
+	}
+	stale, _, err := u.CreateKioskToken(now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CreateKioskToken() error = %v", err)
+	}
+	foreign, _, err := (&attendanceUsecase{jwtSecret: "other"}).CreateKioskToken(now)
+	if err != nil {
+		t.Fatalf("CreateKioskToken() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		division   string
+		token      string
+		clientIP   string
+		wantRemote bool
+		wantErr    error
+	}{
+		{"token at a shared location", "Finance", token, "198.51.100.7", false, nil},
+		{"token outside a shared flagging location", "Finance", token, homeIP, true, nil},
+		{"stale token", "Finance", stale, officeIP, false, domain.ErrInvalidKioskToken},
+		{"token signed with another secret", "Finance", foreign, officeIP, false, domain.ErrInvalidKioskToken},
+		{"token at the office", "Engineering", token, officeIP, false, nil},
+		{"token outside a rejecting office", "Engineering", token, homeIP, false, domain.ErrOutsideOffice},
+		{"token outside a flagging office", "Design", token, homeIP, true, nil},
+		{"no token in a hybrid division at the office", "Engineering", "", officeIP, false, nil},
+		{"no token in a hybrid division elsewhere", "Engineering", "", homeIP, false, domain.ErrOutsideOffice},
+		{"no token in a non-hybrid division", "Design", "", officeIP, false, domain.ErrInvalidKioskToken},
+		{"shared hybrid location does not count", "Finance", "", "198.51.100.7", false, domain.ErrInvalidKioskToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote, err := u.verifyCheckInLocation(tt.division, domain.CheckInInput{KioskToken: tt.token, ClientIP: tt.clientIP}, now)
+			if err != tt.wantErr {
+				t.Fatalf("verifyCheckInLocation() error = %v, want %v", err, tt.wantErr)
+			}
+			if remote != tt.wantRemote {
+				t.Errorf("verifyCheckInLocation() remote = %v, want %v", remote, tt.wantRemote)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// The fakes embed the repository interfaces so that a test calling a method
+// they do not implement fails loudly with a nil pointer panic.
+
+type fakeTaskRepo struct {
+	domain.TaskRepository
+	tasks    map[uint]*domain.Task
+	blockers map[uint][]uint // task ID to the IDs of the tasks blocking it
+	history  []domain.TaskStatusHistory
+}
+
+func newFakeTaskRepo(tasks ...domain.Task) *fakeTaskRepo {
+	repo := &fakeTaskRepo{
+		tasks:    make(map[uint]*domain.Task),
+		blockers: make(map[uint][]uint),
+	}
+	for i := range tasks {
+		repo.tasks[tasks[i].ID] = &tasks[i]
+	}
+	return repo
+}
+
+func (r *fakeTaskRepo) GetByID(id uint) (*domain.Task, error) {
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, domain.ErrTaskNotFound
+	}
+	found := *task
+	found.Subtasks, _ = r.GetSubtasks(id)
+	return &found, nil
+}
+
+func (r *fakeTaskRepo) GetSubtasks(parentID uint) ([]domain.Task, error) {
+	var subtasks []domain.Task
+	for _, task := range r.tasks {
+		if task.ParentID != nil && *task.ParentID == parentID {
+			subtasks = append(subtasks, *task)
+		}
+	}
+	return subtasks, nil
+}
+
+func (r *fakeTaskRepo) GetBlockers(taskID uint) ([]domain.Task, error) {
+	var blockers []domain.Task
+	for _, id := range r.blockers[taskID] {
+		blockers = append(blockers, *r.tasks[id])
+	}
+	return blockers, nil
+}
+
+func (r *fakeTaskRepo) UpdateStatus(task *domain.Task, history *domain.TaskStatusHistory) error {
+	saved := *task
+	saved.Subtasks = nil
+	r.tasks[task.ID] = &saved
+	r.history = append(r.history, *history)
+	return nil
+}
+
+func (r *fakeTaskRepo) AddDependency(dependency *domain.TaskDependency) error {
+	r.blockers[dependency.TaskID] = append(r.blockers[dependency.TaskID], dependency.BlockedByID)
+	return nil
+}
+
+type fakeInternRepo struct {
+	domain.InternRepository
+	profiles []domain.InternProfile
+}
+
+func (r *fakeInternRepo) GetByUserID(userID uint) (*domain.InternProfile, error) {
+	for i := range r.profiles {
+		if r.profiles[i].UserID == userID {
+			profile := r.profiles[i]
+			return &profile, nil
+		}
+	}
+	return nil, domain.ErrInternNotFound
+}
+
+func (r *fakeInternRepo) GetActiveBetween(from, to time.Time) ([]domain.InternProfile, error) {
+	var active []domain.InternProfile
+	for _, profile := range r.profiles {
+		if !profile.StartDate.After(to) && !profile.EndDate.Before(from) {
+			active = append(active, profile)
+		}
+	}
+	return active, nil
+}
+
+func (r *fakeInternRepo) FindByCohort(batch, division string, picID uint) ([]domain.InternProfile, error) {
+	var cohort []domain.InternProfile
+	for _, profile := range r.profiles {
+		if profile.Batch == batch && profile.Division == division && (picID == 0 || profile.PICID == picID) {
+			cohort = append(cohort, profile)
+		}
+	}
+	return cohort, nil
+}
+
+// fakeScheduleRepo resolves schedules like the real repository: the most specific
+// of division and batch, division, batch and the default wins
+type fakeScheduleRepo struct {
+	domain.WorkScheduleRepository
+	schedules []domain.WorkSchedule
+}
+
+func (r *fakeScheduleRepo) FindFor(division, batch string) (*domain.WorkSchedule, error) {
+	for _, key := range [][2]string{{division, batch}, {division, ""}, {"", batch}, {"", ""}} {
+		for i := range r.schedules {
+			if r.schedules[i].Division == key[0] && r.schedules[i].Batch == key[1] {
+				schedule := r.schedules[i]
+				return &schedule, nil
+			}
+		}
+	}
+	return nil, domain.ErrWorkScheduleNotFound
+}
+
+type fakeHolidayRepo struct {
+	domain.HolidayRepository
+	holidays []domain.Holiday
+}
+
+func (r *fakeHolidayRepo) GetBetween(from, to time.Time) ([]domain.Holiday, error) {
+	var holidays []domain.Holiday
+	for _, holiday := range r.holidays {
+		if !holiday.Date.Before(from) && !holiday.Date.After(to) {
+			holidays = append(holidays, holiday)
+		}
+	}
+	return holidays, nil
+}
+
+type fakeAttendanceRepo struct {
+	domain.AttendanceRepository
+	created []domain.Attendance
+}
+
+func (r *fakeAttendanceRepo) CreateMissing(records []domain.Attendance) (int64, error) {
+	r.created = append(r.created, records...)
+	return int64(len(records)), nil
+}
+
+type fakeLocationRepo struct {
+	domain.OfficeLocationRepository
+	locations []domain.OfficeLocation
+}
+
+func (r *fakeLocationRepo) GetForDivision(division string) ([]domain.OfficeLocation, error) {
+	var locations []domain.OfficeLocation
+	for _, location := range r.locations {
+		if location.Division == division || location.Division == "" {
+			locations = append(locations, location)
+		}
+	}
+	return locations, nil
+}
+
+type fakePeerFeedbackRepo struct {
+	domain.PeerFeedbackRepository
+	feedback []domain.PeerFeedback
+}
+
+func (r *fakePeerFeedbackRepo) GetFeedback(roundID uint) ([]domain.PeerFeedback, error) {
+	var feedback []domain.PeerFeedback
+	for _, response := range r.feedback {
+		if response.RoundID == roundID {
+			feedback = append(feedback, response)
+		}
+	}
+	return feedback, nil
+}
+
+type fakeRubricRepo struct {
+	domain.ReviewRubricRepository
+	rubrics []domain.ReviewRubric
+}
+
+func (r *fakeRubricRepo) GetByID(id uint) (*domain.ReviewRubric, error) {
+	for i := range r.rubrics {
+		if r.rubrics[i].ID == id {
+			rubric := r.rubrics[i]
+			return &rubric, nil
+		}
+	}
+	return nil, domain.ErrRubricNotFound
+}
+
+// FindFor returns the latest version effective in the period, preferring the division's own rubrics
+func (r *fakeRubricRepo) FindFor(division, period string) (*domain.ReviewRubric, error) {
+	for _, scope := range []string{division, ""} {
+		var found *domain.ReviewRubric
+		for i := range r.rubrics {
+			rubric := &r.rubrics[i]
+			if rubric.Division == scope && rubric.EffectivePeriod <= period && (found == nil || rubric.Version > found.Version) {
+				found = rubric
+			}
+		}
+		if found != nil {
+			rubric := *found
+			return &rubric, nil
+		}
+	}
+	return nil, domain.ErrRubricNotFound
+}
+
+type fakeMentorReviewRepo struct {
+	domain.MentorReviewRepository
+	reviews []domain.MentorReview
+}
+
+func (r *fakeMentorReviewRepo) GetByInternAndPeriod(internID uint, period string) (*domain.MentorReview, error) {
+	for i := range r.reviews {
+		if r.reviews[i].InternID == internID && r.reviews[i].Period == period {
+			review := r.reviews[i]
+			return &review, nil
+		}
+	}
+	return nil, domain.ErrMentorReviewNotFound
+}
+
+type fakeSelfAssessmentRepo struct {
+	domain.SelfAssessmentRepository
+	assessments []domain.SelfAssessment
+}
+
+func (r *fakeSelfAssessmentRepo) GetByInternAndPeriod(internID uint, period string) (*domain.SelfAssessment, error) {
+	for i := range r.assessments {
+		if r.assessments[i].InternID == internID && r.assessments[i].Period == period {
+			assessment := r.assessments[i]
+			return &assessment, nil
+		}
+	}
+	return nil, domain.ErrSelfAssessmentNotFound
+}
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+	"backend-dashboard/pkg/ical"
+)
+
+// maxSuggestionDays bounds deadline suggestions to a sensible planning horizon
+const maxSuggestionDays = 366
+
+type holidayUsecase struct {
+	holidayRepo  domain.HolidayRepository
+	scheduleRepo domain.WorkScheduleRepository
+	internRepo   domain.InternRepository
+}
+
+// NewHolidayUsecase creates a new holiday usecase
+func NewHolidayUsecase(holidayRepo domain.HolidayRepository, scheduleRepo domain.WorkScheduleRepository, internRepo domain.InternRepository) domain.HolidayUsecase {
+	return &holidayUsecase{
+		holidayRepo:  holidayRepo,
+		scheduleRepo: scheduleRepo,
+		internRepo:   internRepo,
+	}
+}
+
+// CreateHoliday adds a non-working day to the calendar. Interns already marked alpha
+// on that day lose the alpha record.
+func (u *holidayUsecase) CreateHoliday(actorID uint, date time.Time, name, holidayType string) (*domain.Holiday, error) {
+	if holidayType != domain.HolidayPublic && holidayType != domain.HolidayCutiBersama {
+		return nil, domain.ErrInvalidHolidayType
+	}
+
+	date = startOfDay(date)
+	if _, err := u.holidayRepo.GetByDate(date); err == nil {
+		return nil, domain.ErrHolidayExists
+	} else if err != domain.ErrHolidayNotFound {
+		return nil, err
+	}
+
+	now := time.Now()
+	holiday := &domain.Holiday{
+		Date:        date,
+		Name:        name,
+		Type:        holidayType,
+		Source:      "manual",
+		CreatedByID: actorID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := u.holidayRepo.Create(holiday); err != nil {
+		return nil, err
+	}
+
+	return holiday, nil
+}
+
+// GetHolidays lists the holidays within [from, to]
+func (u *holidayUsecase) GetHolidays(from, to time.Time) ([]domain.Holiday, error) {
+	return u.holidayRepo.GetBetween(startOfDay(from), startOfDay(to))
+}
+
+// UpdateHoliday updates a holiday
+func (u *holidayUsecase) UpdateHoliday(id uint, date time.Time, name, holidayType string) (*domain.Holiday, error) {
+	if holidayType != domain.HolidayPublic && holidayType != domain.HolidayCutiBersama {
+		return nil, domain.ErrInvalidHolidayType
+	}
+
+	holiday, err := u.holidayRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	date = startOfDay(date)
+	if existing, err := u.holidayRepo.GetByDate(date); err == nil && existing.ID != id {
+		return nil, domain.ErrHolidayExists
+	} else if err != nil && err != domain.ErrHolidayNotFound {
+		return nil, err
+	}
+
+	holiday.Date = date
+	holiday.Name = name
+	holiday.Type = holidayType
+	holiday.UpdatedAt = time.Now()
+
+	if err := u.holidayRepo.Update(holiday); err != nil {
+		return nil, err
+	}
+
+	return holiday, nil
+}
+
+// DeleteHoliday removes a holiday from the calendar
+func (u *holidayUsecase) DeleteHoliday(id uint) error {
+	if _, err := u.holidayRepo.GetByID(id); err != nil {
+		return err
+	}
+
+	return u.holidayRepo.Delete(id)
+}
+
+// ImportICal adds every day covered by the events of an iCalendar file. Events whose
+// summary mentions cuti bersama are imported as such; the rest get holidayType.
+// Days already on the calendar are skipped; alpha records on the imported days are retracted.
+func (u *holidayUsecase) ImportICal(actorID uint, content io.Reader, holidayType string) (*domain.HolidayImportResult, error) {
+	if holidayType == "" {
+		holidayType = domain.HolidayPublic
+	}
+	if holidayType != domain.HolidayPublic && holidayType != domain.HolidayCutiBersama {
+		return nil, domain.ErrInvalidHolidayType
+	}
+
+	events, err := ical.Parse(content, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidCalendarFile
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool)
+	var holidays []domain.Holiday
+	for _, event := range events {
+		eventType := holidayType
+		if strings.Contains(strings.ToLower(event.Summary), "cuti bersama") {
+			eventType = domain.HolidayCutiBersama
+		}
+
+		for _, day := range event.Days(time.Local) {
+			key := day.Format("2006-01-02")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			holidays = append(holidays, domain.Holiday{
+				Date:        day,
+				Name:        event.Summary,
+				Type:        eventType,
+				Source:      "ical",
+				CreatedByID: actorID,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			})
+		}
+	}
+
+	created, err := u.holidayRepo.CreateMissing(holidays)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.HolidayImportResult{
+		Created: created,
+		Skipped: int64(len(holidays)) - created,
+	}, nil
+}
+
+// SuggestDeadline returns the end of the day that is workingDays working days after from,
+// skipping weekends and holidays. With an intern, their work schedule decides the working days.
+func (u *holidayUsecase) SuggestDeadline(internID uint, from time.Time, workingDays int) (time.Time, error) {
+	if workingDays < 1 || workingDays > maxSuggestionDays {
+		return time.Time{}, domain.ErrInvalidWorkingDays
+	}
+
+	var division, batch string
+	if internID != 0 {
+		profile, err := u.internRepo.GetByUserID(internID)
+		if err != nil {
+			return time.Time{}, err
+		}
+		division, batch = profile.Division, profile.Batch
+	}
+
+	// Even a sparse schedule covers the requested working days within a few times the span
+	from = startOfDay(from)
+	horizon := from.AddDate(0, 0, workingDays*7+31)
+
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, from, horizon)
+	if err != nil {
+		return time.Time{}, err
+	}
+	days, err := calendar.workingDays(division, batch, from.AddDate(0, 0, 1), horizon)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(days) < workingDays {
+		return time.Time{}, domain.ErrInvalidWorkingDays
+	}
+
+	return endOfDay(days[workingDays-1]), nil
+}
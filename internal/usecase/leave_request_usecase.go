@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"backend-dashboard/internal/domain"
+	"backend-dashboard/pkg/storage"
+)
+
+var leaveCategories = map[string]bool{
+	domain.LeaveSick:     true,
+	domain.LeaveCampus:   true,
+	domain.LeavePersonal: true,
+}
+
+type leaveRequestUsecase struct {
+	leaveRepo    domain.LeaveRequestRepository
+	scheduleRepo domain.WorkScheduleRepository
+	holidayRepo  domain.HolidayRepository
+	internRepo   domain.InternRepository
+	storage      storage.Storage
+	maxSize      int64
+	maxLeaveDays int
+}
+
+// NewLeaveRequestUsecase creates a new leave request usecase
+func NewLeaveRequestUsecase(leaveRepo domain.LeaveRequestRepository, scheduleRepo domain.WorkScheduleRepository, holidayRepo domain.HolidayRepository, internRepo domain.InternRepository, store storage.Storage, maxSize int64, maxLeaveDays int) domain.LeaveRequestUsecase {
+	return &leaveRequestUsecase{
+		leaveRepo:    leaveRepo,
+		scheduleRepo: scheduleRepo,
+		holidayRepo:  holidayRepo,
+		internRepo:   internRepo,
+		storage:      store,
+		maxSize:      maxSize,
+		maxLeaveDays: maxLeaveDays,
+	}
+}
+
+// SubmitLeave files a leave request for the acting intern. Only working days count
+// towards the allowance, and pending requests are reserved against it.
+func (u *leaveRequestUsecase) SubmitLeave(actorID, actorRoleID uint, startDate, endDate time.Time, category, reason string, document *domain.LeaveDocument) (*domain.LeaveRequest, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+	if !leaveCategories[category] {
+		return nil, domain.ErrInvalidLeaveCategory
+	}
+
+	startDate, endDate = startOfDay(startDate), startOfDay(endDate)
+	if endDate.Before(startDate) {
+		return nil, domain.ErrInvalidLeavePeriod
+	}
+
+	profile, err := u.internRepo.GetByUserID(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if startDate.Before(startOfDay(profile.StartDate)) || endDate.After(startOfDay(profile.EndDate)) {
+		return nil, domain.ErrInvalidLeavePeriod
+	}
+
+	days, err := u.workingDays(profile, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	if len(days) == 0 {
+		return nil, domain.ErrInvalidLeavePeriod
+	}
+
+	overlapping, err := u.leaveRepo.GetOverlapping(actorID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	if len(overlapping) > 0 {
+		return nil, domain.ErrLeaveOverlap
+	}
+
+	balance, err := u.balance(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if len(days) > balance.Remaining {
+		return nil, domain.ErrLeaveQuotaExceeded
+	}
+
+	now := time.Now()
+	leave := &domain.LeaveRequest{
+		InternID:  actorID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Days:      len(days),
+		Category:  category,
+		Reason:    reason,
+		Status:    domain.LeavePending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if document != nil {
+		if document.Size > u.maxSize {
+			return nil, domain.ErrFileTooLarge
+		}
+
+		key, err := uploadKey(fmt.Sprintf("leave/%d", actorID), document.FileName)
+		if err != nil {
+			return nil, err
+		}
+
+		stored, err := storeUpload(u.storage, key, document.Content, u.maxSize)
+		if err != nil {
+			return nil, err
+		}
+
+		leave.DocumentName = filepath.Base(document.FileName)
+		leave.DocumentContentType = stored.ContentType
+		leave.DocumentSize = stored.Size
+		leave.DocumentKey = key
+	}
+
+	if err := u.leaveRepo.Create(leave); err != nil {
+		if leave.HasDocument() {
+			u.storage.Delete(leave.DocumentKey)
+		}
+		return nil, err
+	}
+
+	return u.leaveRepo.GetByID(leave.ID)
+}
+
+// GetLeaveRequests lists leave requests visible to the actor.
+// Interns only see their own, PICs see their interns, HR sees everything.
+func (u *leaveRequestUsecase) GetLeaveRequests(actorID, actorRoleID uint, filter domain.LeaveRequestFilter, page, limit int) ([]domain.LeaveRequest, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		// HR sees everything
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+	case actorRoleID == domain.RoleIntern:
+		filter.InternID = actorID
+	default:
+		return nil, 0, domain.ErrForbidden
+	}
+
+	return u.leaveRepo.GetAll(filter, page, limit)
+}
+
+// GetLeaveRequestByID gets a leave request the actor can see
+func (u *leaveRequestUsecase) GetLeaveRequestByID(actorID, actorRoleID, id uint) (*domain.LeaveRequest, error) {
+	leave, err := u.leaveRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.authorizeView(actorID, actorRoleID, leave); err != nil {
+		return nil, err
+	}
+
+	return leave, nil
+}
+
+// OpenDocument opens the supporting document of a leave request the actor can see
+func (u *leaveRequestUsecase) OpenDocument(actorID, actorRoleID, id uint) (*domain.LeaveRequest, io.ReadCloser, error) {
+	leave, err := u.GetLeaveRequestByID(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !leave.HasDocument() {
+		return nil, nil, domain.ErrLeaveDocumentNotFound
+	}
+
+	file, err := u.storage.Open(leave.DocumentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return leave, file, nil
+}
+
+// ApproveLeave approves a pending request and records its working days as izin.
+// Only the intern's PIC or HR may approve.
+func (u *leaveRequestUsecase) ApproveLeave(actorID, actorRoleID, id uint) (*domain.LeaveRequest, error) {
+	leave, err := u.pendingLeave(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := u.internRepo.GetByUserID(leave.InternID)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := u.workingDays(profile, leave.StartDate, leave.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	records := make([]domain.Attendance, len(days))
+	for i, day := range days {
+		records[i] = domain.Attendance{
+			InternID:  leave.InternID,
+			Date:      day,
+			Status:    domain.AttendanceIzin,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	leave.Status = domain.LeaveApproved
+	leave.ReviewedByID = &actorID
+	leave.ReviewedAt = &now
+	leave.UpdatedAt = now
+
+	if err := u.leaveRepo.Approve(leave, records); err != nil {
+		return nil, err
+	}
+
+	return u.leaveRepo.GetByID(leave.ID)
+}
+
+// RejectLeave rejects a pending request with a reason. Only the intern's PIC or HR may reject.
+func (u *leaveRequestUsecase) RejectLeave(actorID, actorRoleID, id uint, reason string) (*domain.LeaveRequest, error) {
+	if reason == "" {
+		return nil, domain.ErrReasonRequired
+	}
+
+	leave, err := u.pendingLeave(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	leave.Status = domain.LeaveRejected
+	leave.RejectionReason = reason
+	leave.ReviewedByID = &actorID
+	leave.ReviewedAt = &now
+	leave.UpdatedAt = now
+
+	if err := u.leaveRepo.Update(leave); err != nil {
+		return nil, err
+	}
+
+	return u.leaveRepo.GetByID(leave.ID)
+}
+
+// CancelLeave withdraws one of the intern's own pending requests
+func (u *leaveRequestUsecase) CancelLeave(actorID, actorRoleID, id uint) error {
+	leave, err := u.leaveRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if actorRoleID != domain.RoleIntern || leave.InternID != actorID {
+		return domain.ErrForbidden
+	}
+	if leave.Status != domain.LeavePending {
+		return domain.ErrLeaveNotPending
+	}
+
+	if err := u.leaveRepo.Delete(leave.ID); err != nil {
+		return err
+	}
+
+	if leave.HasDocument() {
+		return u.storage.Delete(leave.DocumentKey)
+	}
+	return nil
+}
+
+// GetLeaveBalance gets how much of the leave allowance an intern has used
+func (u *leaveRequestUsecase) GetLeaveBalance(actorID, actorRoleID, internID uint) (*domain.LeaveBalance, error) {
+	if actorRoleID == domain.RoleIntern {
+		if internID != actorID {
+			return nil, domain.ErrForbidden
+		}
+	} else if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return nil, err
+	}
+
+	return u.balance(internID)
+}
+
+func (u *leaveRequestUsecase) balance(internID uint) (*domain.LeaveBalance, error) {
+	approved, err := u.leaveRepo.SumDays(internID, domain.LeaveApproved)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := u.leaveRepo.SumDays(internID, domain.LeavePending)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := u.maxLeaveDays - approved - pending
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return &domain.LeaveBalance{
+		InternID:  internID,
+		Allowance: u.maxLeaveDays,
+		Approved:  approved,
+		Pending:   pending,
+		Remaining: remaining,
+	}, nil
+}
+
+// pendingLeave loads a pending request the actor may review
+func (u *leaveRequestUsecase) pendingLeave(actorID, actorRoleID, id uint) (*domain.LeaveRequest, error) {
+	leave, err := u.leaveRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, leave.InternID); err != nil {
+		return nil, err
+	}
+	if leave.Status != domain.LeavePending {
+		return nil, domain.ErrLeaveNotPending
+	}
+
+	return leave, nil
+}
+
+func (u *leaveRequestUsecase) authorizeView(actorID, actorRoleID uint, leave *domain.LeaveRequest) error {
+	if actorRoleID == domain.RoleIntern {
+		if leave.InternID != actorID {
+			return domain.ErrForbidden
+		}
+		return nil
+	}
+
+	return authorizeInternManagement(u.internRepo, actorID, actorRoleID, leave.InternID)
+}
+
+// workingDays lists the days in [from, to] on which the intern is expected at work
+func (u *leaveRequestUsecase) workingDays(profile *domain.InternProfile, from, to time.Time) ([]time.Time, error) {
+	calendar, err := loadWorkCalendar(u.scheduleRepo, u.holidayRepo, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar.workingDays(profile.Division, profile.Batch, from, to)
+}
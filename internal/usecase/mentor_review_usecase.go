@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+type mentorReviewUsecase struct {
+	reviewRepo     domain.MentorReviewRepository
+	assessmentRepo domain.SelfAssessmentRepository
+	rubricRepo     domain.ReviewRubricRepository
+	cycleRepo      domain.ReviewCycleRepository
+	internRepo     domain.InternRepository
+}
+
+// NewMentorReviewUsecase creates a new mentor review usecase
+func NewMentorReviewUsecase(reviewRepo domain.MentorReviewRepository, assessmentRepo domain.SelfAssessmentRepository, rubricRepo domain.ReviewRubricRepository, cycleRepo domain.ReviewCycleRepository, internRepo domain.InternRepository) domain.MentorReviewUsecase {
+	return &mentorReviewUsecase{
+		reviewRepo:     reviewRepo,
+		assessmentRepo: assessmentRepo,
+		rubricRepo:     rubricRepo,
+		cycleRepo:      cycleRepo,
+		internRepo:     internRepo,
+	}
+}
+
+// CreateReview lets a PIC start a draft review of one of their own interns for a YYYY-MM
+// period that overlaps the internship, while the period's review cycle is open.
+// The review is scored against the rubric of the intern's self-assessment for the period, or
+// else the rubric that applies to the intern's division in that period.
+func (u *mentorReviewUsecase) CreateReview(actorID, actorRoleID, internID uint, period string, answers []domain.ReviewAnswerInput, notes string) (*domain.MentorReview, error) {
+	profile, err := u.authorizeReviewer(actorID, actorRoleID, internID)
+	if err != nil {
+		return nil, err
+	}
+
+	month, err := time.ParseInLocation("2006-01", period, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	if month.AddDate(0, 1, -1).Before(startOfDay(profile.StartDate)) || month.After(startOfDay(profile.EndDate)) {
+		return nil, domain.ErrOutsideInternshipPeriod
+	}
+
+	now := time.Now()
+	if err := reviewWindowOpen(u.cycleRepo, internID, period, now); err != nil {
+		return nil, err
+	}
+
+	rubric, err := periodRubric(u.rubricRepo, u.reviewRepo, u.assessmentRepo, profile, period)
+	if err != nil {
+		return nil, err
+	}
+	scored, score, err := scoreAnswers(rubric, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &domain.MentorReview{
+		InternID:  internID,
+		PICID:     actorID,
+		RubricID:  &rubric.ID,
+		Answers:   scored,
+		Score:     score,
+		Notes:     notes,
+		Period:    period,
+		Status:    domain.ReviewDraft,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := u.reviewRepo.Create(review); err != nil {
+		return nil, err
+	}
+
+	return u.reviewRepo.GetByID(review.ID)
+}
+
+// UpdateReview replaces the answers and notes of a draft review.
+// The review keeps its rubric version; legacy reviews without one cannot be rewritten,
+// since scoring them against a rubric would discard their ratings.
+func (u *mentorReviewUsecase) UpdateReview(actorID, actorRoleID, id uint, answers []domain.ReviewAnswerInput, notes string) (*domain.MentorReview, error) {
+	review, err := u.editableReview(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Rubric == nil {
+		return nil, domain.ErrLegacyMentorReview
+	}
+
+	scored, score, err := scoreAnswers(review.Rubric, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Answers = scored
+	review.Score = score
+	review.Notes = notes
+	review.PICID = actorID
+	review.UpdatedAt = time.Now()
+
+	if err := u.reviewRepo.ReplaceAnswers(review); err != nil {
+		return nil, err
+	}
+
+	return u.reviewRepo.GetByID(review.ID)
+}
+
+// SubmitReview makes a draft review read-only and visible to the intern
+func (u *mentorReviewUsecase) SubmitReview(actorID, actorRoleID, id uint) (*domain.MentorReview, error) {
+	review, err := u.editableReview(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	review.Status = domain.ReviewSubmitted
+	review.SubmittedAt = &now
+	review.UpdatedAt = now
+
+	if err := u.reviewRepo.Update(review); err != nil {
+		return nil, err
+	}
+
+	return u.reviewRepo.GetByID(review.ID)
+}
+
+// GetReviews lists reviews visible to the actor.
+// Interns only see their own submitted reviews, PICs see their interns, HR sees everything.
+func (u *mentorReviewUsecase) GetReviews(actorID, actorRoleID uint, filter domain.MentorReviewFilter, page, limit int) ([]domain.MentorReview, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		// HR sees everything
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+	case actorRoleID == domain.RoleIntern:
+		filter.InternID = actorID
+		filter.Status = domain.ReviewSubmitted
+	default:
+		return nil, 0, domain.ErrForbidden
+	}
+
+	return u.reviewRepo.GetAll(filter, page, limit)
+}
+
+// GetReviewByID gets a review visible to the actor
+func (u *mentorReviewUsecase) GetReviewByID(actorID, actorRoleID, id uint) (*domain.MentorReview, error) {
+	review, err := u.reviewRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorRoleID == domain.RoleIntern {
+		// Drafts do not exist as far as the intern is concerned
+		if review.InternID != actorID || !review.IsSubmitted() {
+			return nil, domain.ErrMentorReviewNotFound
+		}
+		return review, nil
+	}
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, review.InternID); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// authorizeReviewer allows only the intern's own PIC to write reviews
+func (u *mentorReviewUsecase) authorizeReviewer(actorID, actorRoleID, internID uint) (*domain.InternProfile, error) {
+	if actorRoleID != domain.RolePIC {
+		return nil, domain.ErrForbidden
+	}
+
+	profile, err := u.internRepo.GetByUserID(internID)
+	if err != nil {
+		return nil, err
+	}
+	if profile.PICID != actorID {
+		return nil, domain.ErrForbidden
+	}
+
+	return profile, nil
+}
+
+// editableReview loads a review the actor may change and checks it is still a draft
+// within an open review window
+func (u *mentorReviewUsecase) editableReview(actorID, actorRoleID, id uint) (*domain.MentorReview, error) {
+	review, err := u.reviewRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.authorizeReviewer(actorID, actorRoleID, review.InternID); err != nil {
+		return nil, err
+	}
+	if review.IsSubmitted() {
+		return nil, domain.ErrMentorReviewSubmitted
+	}
+	if err := reviewWindowOpen(u.cycleRepo, review.InternID, review.Period, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// scoreAnswers checks that the answers score every criterion of the rubric exactly once
+// within its scale and returns them with the weighted review score
+func scoreAnswers(rubric *domain.ReviewRubric, answers []domain.ReviewAnswerInput) ([]domain.ReviewAnswer, float64, error) {
+	if len(answers) != len(rubric.Criteria) {
+		return nil, 0, domain.ErrInvalidReviewAnswers
+	}
+
+	criteria := make(map[uint]bool, len(rubric.Criteria))
+	for _, criterion := range rubric.Criteria {
+		criteria[criterion.ID] = true
+	}
+
+	scores := make(map[uint]int, len(answers))
+	scored := make([]domain.ReviewAnswer, 0, len(answers))
+	for _, answer := range answers {
+		if _, seen := scores[answer.CriterionID]; seen || !criteria[answer.CriterionID] {
+			return nil, 0, domain.ErrInvalidReviewAnswers
+		}
+		if answer.Score < 1 || answer.Score > rubric.Scale {
+			return nil, 0, domain.ErrInvalidRating
+		}
+		scores[answer.CriterionID] = answer.Score
+		scored = append(scored, domain.ReviewAnswer{
+			CriterionID: answer.CriterionID,
+			Score:       answer.Score,
+			Comment:     answer.Comment,
+		})
+	}
+
+	return scored, rubric.Score(scores), nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"math"
+	"testing"
+
+	"backend-dashboard/internal/domain"
+)
+
+func TestScoreAnswers(t *testing.T) {
+	rubric := &domain.ReviewRubric{
+		Scale: 5,
+		Criteria: []domain.RubricCriterion{
+			{ID: 1, Name: "Technical skill", Weight: 1},
+			{ID: 2, Name: "Communication", Weight: 1},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		answers   []domain.ReviewAnswerInput
+		wantScore float64
+		wantErr   error
+	}{
+		{
+			name:      "every criterion scored",
+			answers:   []domain.ReviewAnswerInput{{CriterionID: 1, Score: 5, Comment: "Solid"}, {CriterionID: 2, Score: 3}},
+			wantScore: 80,
+		},
+		{
+			name:    "missing criterion",
+			answers: []domain.ReviewAnswerInput{{CriterionID: 1, Score: 5}},
+			wantErr: domain.ErrInvalidReviewAnswers,
+		},
+		{
+			name:    "extra answer",
+			answers: []domain.ReviewAnswerInput{{CriterionID: 1, Score: 5}, {CriterionID: 2, Score: 3}, {CriterionID: 3, Score: 3}},
+			wantErr: domain.ErrInvalidReviewAnswers,
+		},
+		{
+			name:    "duplicate criterion",
+			answers: []domain.ReviewAnswerInput{{CriterionID: 1, Score: 5}, {CriterionID: 1, Score: 3}},
+			wantErr: domain.ErrInvalidReviewAnswers,
+		},
+		{
+			name:    "criterion of another rubric",
+			answers: []domain.ReviewAnswerInput{{CriterionID: 1, Score: 5}, {CriterionID: 7, Score: 3}},
+			wantErr: domain.ErrInvalidReviewAnswers,
+		},
+		{
+			name:    "score below the scale",
+			answers: []domain.ReviewAnswerInput{{CriterionID: 1, Score: 0}, {CriterionID: 2, Score: 3}},
+			wantErr: domain.ErrInvalidRating,
+		},
+		{
+			name:    "score above the scale",
+			answers: []domain.ReviewAnswerInput{{CriterionID: 1, Score: 5}, {CriterionID: 2, Score: 6}},
+			wantErr: domain.ErrInvalidRating,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scored, score, err := scoreAnswers(rubric, tt.answers)
+			if err != tt.wantErr {
+				t.Fatalf("scoreAnswers() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if math.Abs(score-tt.wantScore) > 1e-9 {
+				t.Errorf("scoreAnswers() score = %v, want %v", score, tt.wantScore)
+			}
+			if len(scored) != len(tt.answers) {
+				t.Fatalf("scoreAnswers() returned %d answers, want %d", len(scored), len(tt.answers))
+			}
+			for i, answer := range scored {
+				input := tt.answers[i]
+				if answer.CriterionID != input.CriterionID || answer.Score != input.Score || answer.Comment != input.Comment {
+					t.Errorf("answer %d = %+v, want %+v", i, answer, input)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+type officeLocationUsecase struct {
+	locationRepo domain.OfficeLocationRepository
+}
+
+// NewOfficeLocationUsecase creates a new office location usecase
+func NewOfficeLocationUsecase(locationRepo domain.OfficeLocationRepository) domain.OfficeLocationUsecase {
+	return &officeLocationUsecase{
+		locationRepo: locationRepo,
+	}
+}
+
+// CreateLocation adds an office interns of a division may check in from.
+// Leave the division empty for an office shared by every division.
+func (u *officeLocationUsecase) CreateLocation(division, name string, cidrs []string, latitude, longitude *float64, radiusMeters int, enforcement string, hybrid bool) (*domain.OfficeLocation, error) {
+	networks, err := validateOfficeLocation(division, cidrs, latitude, longitude, radiusMeters, enforcement, hybrid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	location := &domain.OfficeLocation{
+		Division:     division,
+		Name:         name,
+		CIDRs:        networks,
+		Latitude:     latitude,
+		Longitude:    longitude,
+		RadiusMeters: radiusMeters,
+		Enforcement:  enforcement,
+		Hybrid:       hybrid,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := u.locationRepo.Create(location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// GetLocations gets all office locations
+func (u *officeLocationUsecase) GetLocations() ([]domain.OfficeLocation, error) {
+	return u.locationRepo.GetAll()
+}
+
+// GetLocationByID gets an office location by ID
+func (u *officeLocationUsecase) GetLocationByID(id uint) (*domain.OfficeLocation, error) {
+	return u.locationRepo.GetByID(id)
+}
+
+// UpdateLocation updates an office location
+func (u *officeLocationUsecase) UpdateLocation(id uint, division, name string, cidrs []string, latitude, longitude *float64, radiusMeters int, enforcement string, hybrid bool) (*domain.OfficeLocation, error) {
+	networks, err := validateOfficeLocation(division, cidrs, latitude, longitude, radiusMeters, enforcement, hybrid)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := u.locationRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	location.Division = division
+	location.Name = name
+	location.CIDRs = networks
+	location.Latitude = latitude
+	location.Longitude = longitude
+	location.RadiusMeters = radiusMeters
+	location.Enforcement = enforcement
+	location.Hybrid = hybrid
+	location.UpdatedAt = time.Now()
+
+	if err := u.locationRepo.Update(location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// DeleteLocation deletes an office location
+func (u *officeLocationUsecase) DeleteLocation(id uint) error {
+	if _, err := u.locationRepo.GetByID(id); err != nil {
+		return err
+	}
+
+	return u.locationRepo.Delete(id)
+}
+
+// validateOfficeLocation checks that the office can be matched by network or geofence
+// and returns the networks in their stored form. Hybrid offices must belong to a division
+// so that dropping the kiosk token is always an explicit per-division choice.
+func validateOfficeLocation(division string, cidrs []string, latitude, longitude *float64, radiusMeters int, enforcement string, hybrid bool) (string, error) {
+	if enforcement != domain.EnforcementReject && enforcement != domain.EnforcementFlag {
+		return "", domain.ErrInvalidOfficeLocation
+	}
+	if hybrid && division == "" {
+		return "", domain.ErrInvalidOfficeLocation
+	}
+
+	var networks []string
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return "", domain.ErrInvalidOfficeLocation
+		}
+		networks = append(networks, network.String())
+	}
+
+	hasCoordinates := latitude != nil || longitude != nil
+	if hasCoordinates {
+		if latitude == nil || longitude == nil || radiusMeters <= 0 {
+			return "", domain.ErrInvalidOfficeLocation
+		}
+		if *latitude < -90 || *latitude > 90 || *longitude < -180 || *longitude > 180 {
+			return "", domain.ErrInvalidOfficeLocation
+		}
+	}
+
+	if len(networks) == 0 && !hasCoordinates {
+		return "", domain.ErrInvalidOfficeLocation
+	}
+
+	return strings.Join(networks, ","), nil
+}
@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// defaultMinRespondents is the result threshold of rounds that do not set one.
+// Fewer than two respondents would make feedback attributable.
+const defaultMinRespondents = 3
+
+type peerFeedbackUsecase struct {
+	feedbackRepo domain.PeerFeedbackRepository
+	rubricRepo   domain.ReviewRubricRepository
+	internRepo   domain.InternRepository
+}
+
+// NewPeerFeedbackUsecase creates a new peer feedback usecase
+func NewPeerFeedbackUsecase(feedbackRepo domain.PeerFeedbackRepository, rubricRepo domain.ReviewRubricRepository, internRepo domain.InternRepository) domain.PeerFeedbackUsecase {
+	return &peerFeedbackUsecase{
+		feedbackRepo: feedbackRepo,
+		rubricRepo:   rubricRepo,
+		internRepo:   internRepo,
+	}
+}
+
+// CreateRound opens a peer feedback round for the interns of a batch and division whose
+// internship overlaps the period. Every intern is assigned PeersPerIntern random peers of the
+// cohort so that each intern also receives feedback from exactly that many peers.
+func (u *peerFeedbackUsecase) CreateRound(actorID uint, input domain.PeerFeedbackRoundInput) (*domain.PeerFeedbackRound, error) {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" || input.Batch == "" || input.Division == "" {
+		return nil, domain.ErrInvalidPeerRound
+	}
+	if input.MinRespondents == 0 {
+		input.MinRespondents = defaultMinRespondents
+	}
+	if input.PeersPerIntern == 0 {
+		input.PeersPerIntern = input.MinRespondents
+	}
+	if input.MinRespondents < 2 || input.PeersPerIntern < input.MinRespondents {
+		return nil, domain.ErrInvalidPeerRound
+	}
+
+	month, err := time.ParseInLocation("2006-01", input.Period, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+
+	now := time.Now()
+	deadline := endOfDay(input.Deadline)
+	if deadline.Before(now) {
+		return nil, domain.ErrInvalidReviewDeadline
+	}
+
+	var rubric *domain.ReviewRubric
+	if input.RubricID != 0 {
+		rubric, err = u.rubricRepo.GetByID(input.RubricID)
+	} else {
+		rubric, err = u.rubricRepo.FindFor(input.Division, input.Period)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := u.cohort(input.Batch, input.Division, month, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) <= input.PeersPerIntern {
+		return nil, domain.ErrNotEnoughPeers
+	}
+
+	rand.Shuffle(len(profiles), func(i, j int) {
+		profiles[i], profiles[j] = profiles[j], profiles[i]
+	})
+	assignments := assignPeers(profiles, input.PeersPerIntern, now)
+
+	round := &domain.PeerFeedbackRound{
+		Name:               input.Name,
+		Batch:              input.Batch,
+		Division:           input.Division,
+		Period:             input.Period,
+		RubricID:           rubric.ID,
+		Deadline:           deadline,
+		PeersPerIntern:     input.PeersPerIntern,
+		MinRespondents:     input.MinRespondents,
+		IncludeInPotential: input.IncludeInPotential,
+		CreatedByID:        actorID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if err := u.feedbackRepo.CreateRound(round, assignments); err != nil {
+		return nil, err
+	}
+
+	return u.feedbackRepo.GetRoundByID(round.ID)
+}
+
+// GetRounds gets peer feedback rounds with pagination
+func (u *peerFeedbackUsecase) GetRounds(page, limit int) ([]domain.PeerFeedbackRound, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	return u.feedbackRepo.GetRounds(page, limit)
+}
+
+// GetRoundByID gets a peer feedback round by ID
+func (u *peerFeedbackUsecase) GetRoundByID(id uint) (*domain.PeerFeedbackRound, error) {
+	return u.feedbackRepo.GetRoundByID(id)
+}
+
+// CloseRound stops feedback and publishes the results. For rounds that feed potential,
+// the peer score of every intern above the threshold is written to their potential score.
+func (u *peerFeedbackUsecase) CloseRound(id uint) (*domain.PeerFeedbackRound, error) {
+	round, err := u.feedbackRepo.GetRoundByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if round.ClosedAt != nil {
+		return nil, domain.ErrPeerRoundClosed
+	}
+
+	now := time.Now()
+	round.ClosedAt = &now
+	round.UpdatedAt = now
+
+	var scores []domain.PotentialScore
+	if round.IncludeInPotential {
+		results, err := u.results(round, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			if !result.Visible {
+				continue
+			}
+			scores = append(scores, domain.PotentialScore{
+				InternID:  result.InternID,
+				Period:    round.Period,
+				PeerScore: result.Score,
+				CreatedAt: now,
+			})
+		}
+	}
+
+	if err := u.feedbackRepo.CloseRound(round, scores); err != nil {
+		return nil, err
+	}
+
+	return u.feedbackRepo.GetRoundByID(round.ID)
+}
+
+// GetAssignments lists the peers the intern was asked to give feedback to.
+// With pendingOnly, only feedback that can still be given is listed.
+func (u *peerFeedbackUsecase) GetAssignments(actorID, actorRoleID uint, pendingOnly bool) ([]domain.PeerFeedbackAssignment, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	assignments, err := u.feedbackRepo.GetAssignments(actorID, pendingOnly)
+	if err != nil {
+		return nil, err
+	}
+	if !pendingOnly {
+		return assignments, nil
+	}
+
+	now := time.Now()
+	open := make([]domain.PeerFeedbackAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.Round != nil && assignment.Round.IsOpen(now) {
+			open = append(open, assignment)
+		}
+	}
+	return open, nil
+}
+
+// SubmitFeedback stores the intern's feedback on an assigned peer. Feedback is final
+// because it is stored without a link back to the reviewer.
+func (u *peerFeedbackUsecase) SubmitFeedback(actorID, actorRoleID, assignmentID uint, answers []domain.ReviewAnswerInput, comment string) error {
+	if actorRoleID != domain.RoleIntern {
+		return domain.ErrForbidden
+	}
+
+	assignment, err := u.feedbackRepo.GetAssignmentByID(assignmentID)
+	if err != nil {
+		return err
+	}
+	if assignment.ReviewerID != actorID {
+		return domain.ErrPeerAssignmentNotFound
+	}
+	if assignment.SubmittedAt != nil {
+		return domain.ErrPeerFeedbackSubmitted
+	}
+
+	now := time.Now()
+	round := assignment.Round
+	if !round.IsOpen(now) {
+		return domain.ErrPeerRoundClosed
+	}
+
+	scored, score, err := scoreAnswers(round.Rubric, answers)
+	if err != nil {
+		return err
+	}
+
+	feedback := &domain.PeerFeedback{
+		RoundID:    round.ID,
+		RevieweeID: assignment.RevieweeID,
+		Score:      score,
+		Comment:    strings.TrimSpace(comment),
+	}
+	for _, answer := range scored {
+		feedback.Answers = append(feedback.Answers, domain.PeerFeedbackAnswer{
+			CriterionID: answer.CriterionID,
+			Score:       answer.Score,
+			Comment:     answer.Comment,
+		})
+	}
+
+	assignment.SubmittedAt = &now
+	return u.feedbackRepo.SubmitFeedback(assignment, feedback)
+}
+
+// GetResults gets the aggregated results of a closed round visible to the actor.
+// Interns only get their own result, PICs those of their interns, HR everyone's.
+func (u *peerFeedbackUsecase) GetResults(actorID, actorRoleID, roundID uint) ([]domain.PeerFeedbackResult, error) {
+	round, err := u.feedbackRepo.GetRoundByID(roundID)
+	if err != nil {
+		return nil, err
+	}
+	if round.ClosedAt == nil {
+		return nil, domain.ErrPeerRoundNotClosed
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		return u.results(round, 0)
+	case actorRoleID == domain.RolePIC:
+		return u.results(round, actorID)
+	case actorRoleID == domain.RoleIntern:
+		results, err := u.results(round, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			if result.InternID == actorID {
+				return []domain.PeerFeedbackResult{result}, nil
+			}
+		}
+		return nil, domain.ErrForbidden
+	default:
+		return nil, domain.ErrForbidden
+	}
+}
+
+// assignPeers has every intern give feedback to the peersPerIntern interns after them in the
+// list, wrapping around, so each intern also receives feedback from exactly that many peers.
+// The cohort must have more interns than peersPerIntern.
+func assignPeers(profiles []domain.InternProfile, peersPerIntern int, now time.Time) []domain.PeerFeedbackAssignment {
+	assignments := make([]domain.PeerFeedbackAssignment, 0, len(profiles)*peersPerIntern)
+	for i, reviewer := range profiles {
+		for k := 1; k <= peersPerIntern; k++ {
+			assignments = append(assignments, domain.PeerFeedbackAssignment{
+				ReviewerID: reviewer.UserID,
+				RevieweeID: profiles[(i+k)%len(profiles)].UserID,
+				CreatedAt:  now,
+			})
+		}
+	}
+	return assignments
+}
+
+// cohort gets the interns of a batch and division whose internship overlaps the month.
+// A non-zero picID limits them to that PIC's interns.
+func (u *peerFeedbackUsecase) cohort(batch, division string, month time.Time, picID uint) ([]domain.InternProfile, error) {
+	profiles, err := u.internRepo.FindByCohort(batch, division, picID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := month, month.AddDate(0, 1, -1)
+	active := make([]domain.InternProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		if startOfDay(profile.StartDate).After(to) || startOfDay(profile.EndDate).Before(from) {
+			continue
+		}
+		active = append(active, profile)
+	}
+	return active, nil
+}
+
+// results aggregates the feedback of a round per intern of its cohort.
+// Scores and comments are only filled in from the round's respondent threshold on.
+func (u *peerFeedbackUsecase) results(round *domain.PeerFeedbackRound, picID uint) ([]domain.PeerFeedbackResult, error) {
+	month, err := time.ParseInLocation("2006-01", round.Period, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	profiles, err := u.cohort(round.Batch, round.Division, month, picID)
+	if err != nil {
+		return nil, err
+	}
+	feedback, err := u.feedbackRepo.GetFeedback(round.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byReviewee := make(map[uint][]domain.PeerFeedback)
+	for _, response := range feedback {
+		byReviewee[response.RevieweeID] = append(byReviewee[response.RevieweeID], response)
+	}
+
+	results := make([]domain.PeerFeedbackResult, 0, len(profiles))
+	for _, profile := range profiles {
+		responses := byReviewee[profile.UserID]
+		result := domain.PeerFeedbackResult{
+			InternID:    profile.UserID,
+			FullName:    profile.User.FullName,
+			Respondents: len(responses),
+			Criteria:    []domain.PeerCriterionResult{},
+			Comments:    []string{},
+		}
+		if len(responses) < round.MinRespondents {
+			results = append(results, result)
+			continue
+		}
+
+		result.Visible = true
+		var total float64
+		criterionTotals := make(map[uint]int)
+		criterionCounts := make(map[uint]int)
+		for _, response := range responses {
+			total += response.Score
+			if response.Comment != "" {
+				result.Comments = append(result.Comments, response.Comment)
+			}
+			for _, answer := range response.Answers {
+				criterionTotals[answer.CriterionID] += answer.Score
+				criterionCounts[answer.CriterionID]++
+				if answer.Comment != "" {
+					result.Comments = append(result.Comments, answer.Comment)
+				}
+			}
+		}
+		score := total / float64(len(responses))
+		result.Score = &score
+
+		if round.Rubric != nil {
+			for _, criterion := range round.Rubric.Criteria {
+				if criterionCounts[criterion.ID] == 0 {
+					continue
+				}
+				result.Criteria = append(result.Criteria, domain.PeerCriterionResult{
+					CriterionID:  criterion.ID,
+					Name:         criterion.Name,
+					AverageScore: float64(criterionTotals[criterion.ID]) / float64(criterionCounts[criterion.ID]),
+				})
+			}
+		}
+		// Sorted so the order does not reveal who answered when
+		sort.Strings(result.Comments)
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
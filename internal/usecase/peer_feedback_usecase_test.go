@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+func TestAssignPeers(t *testing.T) {
+	tests := []struct {
+		name           string
+		interns        int
+		peersPerIntern int
+	}{
+		{"smallest cohort", 2, 1},
+		{"three peers in a cohort of four", 4, 3},
+		{"three peers in a larger cohort", 9, 3},
+		{"five peers", 12, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles := make([]domain.InternProfile, tt.interns)
+			for i := range profiles {
+				profiles[i].UserID = uint(100 + i)
+			}
+
+			assignments := assignPeers(profiles, tt.peersPerIntern, time.Now())
+			if len(assignments) != tt.interns*tt.peersPerIntern {
+				t.Fatalf("assignPeers() made %d assignments, want %d", len(assignments), tt.interns*tt.peersPerIntern)
+			}
+
+			given := make(map[uint]int)
+			received := make(map[uint]int)
+			pairs := make(map[[2]uint]bool)
+			for _, assignment := range assignments {
+				if assignment.ReviewerID == assignment.RevieweeID {
+					t.Errorf("intern %d reviews themselves", assignment.ReviewerID)
+				}
+				pair := [2]uint{assignment.ReviewerID, assignment.RevieweeID}
+				if pairs[pair] {
+					t.Errorf("intern %d reviews intern %d twice", pair[0], pair[1])
+				}
+				pairs[pair] = true
+				given[assignment.ReviewerID]++
+				received[assignment.RevieweeID]++
+			}
+			for _, profile := range profiles {
+				if given[profile.UserID] != tt.peersPerIntern || received[profile.UserID] != tt.peersPerIntern {
+					t.Errorf("intern %d gives %d and receives %d, want %d each",
+						profile.UserID, given[profile.UserID], received[profile.UserID], tt.peersPerIntern)
+				}
+			}
+		})
+	}
+}
+
+func TestPeerFeedbackResults(t *testing.T) {
+	const picID, otherPICID = 20, 21
+	cohort := func(userID, pic uint, start, end time.Time) domain.InternProfile {
+		return domain.InternProfile{
+			UserID:    userID,
+			User:      domain.User{FullName: "Intern"},
+			PICID:     pic,
+			Batch:     "2026A",
+			Division:  "Engineering",
+			StartDate: start,
+			EndDate:   end,
+		}
+	}
+	profiles := []domain.InternProfile{
+		cohort(10, picID, date(2026, 1, 5), date(2026, 6, 30)),
+		cohort(11, picID, date(2026, 1, 5), date(2026, 6, 30)),
+		cohort(12, otherPICID, date(2026, 1, 5), date(2026, 6, 30)),
+		cohort(13, picID, date(2026, 4, 1), date(2026, 6, 30)), // starts after the period
+	}
+	response := func(revieweeID uint, score float64, comment string, technical, communication int) domain.PeerFeedback {
+		return domain.PeerFeedback{
+			RoundID:    1,
+			RevieweeID: revieweeID,
+			Score:      score,
+			Comment:    comment,
+			Answers: []domain.PeerFeedbackAnswer{
+				{CriterionID: 1, Score: technical},
+				{CriterionID: 2, Score: communication},
+			},
+		}
+	}
+	feedback := []domain.PeerFeedback{
+		response(10, 80, "Helpful in reviews", 4, 4),
+		response(10, 60, "", 3, 3),
+		response(10, 100, "Always on time", 5, 5),
+		response(11, 40, "Quiet in standups", 2, 2),
+		response(11, 60, "", 3, 3),
+		response(12, 80, "", 4, 4),
+		response(12, 80, "", 4, 4),
+		response(12, 80, "", 4, 4),
+		{RoundID: 2, RevieweeID: 11, Score: 100}, // another round
+	}
+	round := &domain.PeerFeedbackRound{
+		ID:             1,
+		Batch:          "2026A",
+		Division:       "Engineering",
+		Period:         "2026-03",
+		MinRespondents: 3,
+		Rubric: &domain.ReviewRubric{Scale: 5, Criteria: []domain.RubricCriterion{
+			{ID: 1, Name: "Technical skill", Weight: 1},
+			{ID: 2, Name: "Communication", Weight: 1},
+		}},
+	}
+
+	type result struct {
+		respondents int
+		score       *float64
+		criteria    []domain.PeerCriterionResult
+		comments    []string
+	}
+	score := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name           string
+		picID          uint
+		minRespondents int
+		want           map[uint]result
+	}{
+		{
+			name:           "threshold hides interns with too few respondents",
+			minRespondents: 3,
+			want: map[uint]result{
+				10: {3, score(80), []domain.PeerCriterionResult{
+					{CriterionID: 1, Name: "Technical skill", AverageScore: 4},
+					{CriterionID: 2, Name: "Communication", AverageScore: 4},
+				}, []string{"Always on time", "Helpful in reviews"}},
+				11: {2, nil, []domain.PeerCriterionResult{}, []string{}},
+				12: {3, score(80), []domain.PeerCriterionResult{
+					{CriterionID: 1, Name: "Technical skill", AverageScore: 4},
+					{CriterionID: 2, Name: "Communication", AverageScore: 4},
+				}, []string{}},
+			},
+		},
+		{
+			name:           "lower threshold",
+			minRespondents: 2,
+			want: map[uint]result{
+				10: {3, score(80), []domain.PeerCriterionResult{
+					{CriterionID: 1, Name: "Technical skill", AverageScore: 4},
+					{CriterionID: 2, Name: "Communication", AverageScore: 4},
+				}, []string{"Always on time", "Helpful in reviews"}},
+				11: {2, score(50), []domain.PeerCriterionResult{
+					{CriterionID: 1, Name: "Technical skill", AverageScore: 2.5},
+					{CriterionID: 2, Name: "Communication", AverageScore: 2.5},
+				}, []string{"Quiet in standups"}},
+				12: {3, score(80), []domain.PeerCriterionResult{
+					{CriterionID: 1, Name: "Technical skill", AverageScore: 4},
+					{CriterionID: 2, Name: "Communication", AverageScore: 4},
+				}, []string{}},
+			},
+		},
+		{
+			name:           "limited to the PIC's interns",
+			picID:          otherPICID,
+			minRespondents: 3,
+			want: map[uint]result{
+				12: {3, score(80), []domain.PeerCriterionResult{
+					{CriterionID: 1, Name: "Technical skill", AverageScore: 4},
+					{CriterionID: 2, Name: "Communication", AverageScore: 4},
+				}, []string{}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &peerFeedbackUsecase{
+				feedbackRepo: &fakePeerFeedbackRepo{feedback: feedback},
+				internRepo:   &fakeInternRepo{profiles: profiles},
+			}
+			r := *round
+			r.MinRespondents = tt.minRespondents
+
+			results, err := u.results(&r, tt.picID)
+			if err != nil {
+				t.Fatalf("results() error = %v", err)
+			}
+
+			got := make(map[uint]result, len(results))
+			for _, res := range results {
+				if res.Visible != (res.Score != nil) {
+					t.Errorf("intern %d: visible = %v with score %v", res.InternID, res.Visible, res.Score)
+				}
+				got[res.InternID] = result{res.Respondents, res.Score, res.Criteria, res.Comments}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("results() covers %d interns, want %d", len(got), len(tt.want))
+			}
+			for internID, want := range tt.want {
+				res, ok := got[internID]
+				if !ok {
+					t.Errorf("intern %d missing from results", internID)
+					continue
+				}
+				if res.respondents != want.respondents {
+					t.Errorf("intern %d: respondents = %d, want %d", internID, res.respondents, want.respondents)
+				}
+				if (res.score == nil) != (want.score == nil) || (res.score != nil && *res.score != *want.score) {
+					t.Errorf("intern %d: score = %v, want %v", internID, res.score, want.score)
+				}
+				if !reflect.DeepEqual(res.criteria, want.criteria) {
+					t.Errorf("intern %d: criteria = %+v, want %+v", internID, res.criteria, want.criteria)
+				}
+				if !reflect.DeepEqual(res.comments, want.comments) {
+					t.Errorf("intern %d: comments = %q, want %q", internID, res.comments, want.comments)
+				}
+			}
+		})
+	}
+}
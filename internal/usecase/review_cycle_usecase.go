@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+type reviewCycleUsecase struct {
+	cycleRepo  domain.ReviewCycleRepository
+	internRepo domain.InternRepository
+}
+
+// NewReviewCycleUsecase creates a new review cycle usecase
+func NewReviewCycleUsecase(cycleRepo domain.ReviewCycleRepository, internRepo domain.InternRepository) domain.ReviewCycleUsecase {
+	return &reviewCycleUsecase{
+		cycleRepo:  cycleRepo,
+		internRepo: internRepo,
+	}
+}
+
+// OpenCycle opens mentor reviews of a YYYY-MM period until the end of the deadline day
+func (u *reviewCycleUsecase) OpenCycle(actorID uint, period string, deadline time.Time) (*domain.ReviewCycle, error) {
+	if _, err := time.ParseInLocation("2006-01", period, time.Local); err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+
+	now := time.Now()
+	deadline = endOfDay(deadline)
+	if deadline.Before(now) {
+		return nil, domain.ErrInvalidReviewDeadline
+	}
+
+	cycle := &domain.ReviewCycle{
+		Period:     period,
+		Deadline:   deadline,
+		OpenedByID: actorID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := u.cycleRepo.Create(cycle); err != nil {
+		return nil, err
+	}
+
+	return u.cycleRepo.GetByID(cycle.ID)
+}
+
+// GetCycles gets review cycles with pagination
+func (u *reviewCycleUsecase) GetCycles(page, limit int) ([]domain.ReviewCycle, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	return u.cycleRepo.GetAll(page, limit)
+}
+
+// GetCycleByID gets a review cycle by ID
+func (u *reviewCycleUsecase) GetCycleByID(id uint) (*domain.ReviewCycle, error) {
+	return u.cycleRepo.GetByID(id)
+}
+
+// UpdateDeadline moves the deadline of a cycle HR has not closed.
+// Extending a deadline that already passed opens the cycle again.
+func (u *reviewCycleUsecase) UpdateDeadline(id uint, deadline time.Time) (*domain.ReviewCycle, error) {
+	cycle, err := u.cycleRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.ClosedAt != nil {
+		return nil, domain.ErrReviewCycleClosed
+	}
+
+	now := time.Now()
+	deadline = endOfDay(deadline)
+	if deadline.Before(now) {
+		return nil, domain.ErrInvalidReviewDeadline
+	}
+
+	cycle.Deadline = deadline
+	cycle.UpdatedAt = now
+
+	if err := u.cycleRepo.Update(cycle); err != nil {
+		return nil, err
+	}
+
+	return u.cycleRepo.GetByID(cycle.ID)
+}
+
+// CloseCycle closes a cycle before its deadline
+func (u *reviewCycleUsecase) CloseCycle(id uint) (*domain.ReviewCycle, error) {
+	cycle, err := u.cycleRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !cycle.IsOpen(now) {
+		return nil, domain.ErrReviewCycleClosed
+	}
+
+	cycle.ClosedAt = &now
+	cycle.UpdatedAt = now
+
+	if err := u.cycleRepo.Update(cycle); err != nil {
+		return nil, err
+	}
+
+	return u.cycleRepo.GetByID(cycle.ID)
+}
+
+// ReopenForIntern lets the intern's PIC write or change the review of the cycle's period
+// until the end of the deadline day. A submitted review goes back to draft.
+func (u *reviewCycleUsecase) ReopenForIntern(actorID, id, internID uint, deadline time.Time, reason string) (*domain.ReviewReopening, error) {
+	cycle, err := u.cycleRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.internRepo.GetByUserID(internID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	deadline = endOfDay(deadline)
+	if deadline.Before(now) {
+		return nil, domain.ErrInvalidReviewDeadline
+	}
+
+	reopening := &domain.ReviewReopening{
+		CycleID:      cycle.ID,
+		InternID:     internID,
+		Deadline:     deadline,
+		Reason:       reason,
+		ReopenedByID: actorID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := u.cycleRepo.Reopen(reopening, cycle.Period); err != nil {
+		return nil, err
+	}
+
+	return u.cycleRepo.GetReopening(cycle.ID, internID)
+}
+
+// reviewWindowOpen checks that the intern's review of the period can be written now:
+// the period's cycle is open or HR reopened it for the intern
+func reviewWindowOpen(cycleRepo domain.ReviewCycleRepository, internID uint, period string, now time.Time) error {
+	cycle, err := cycleRepo.GetByPeriod(period)
+	if err == domain.ErrReviewCycleNotFound {
+		return domain.ErrReviewCycleClosed
+	}
+	if err != nil {
+		return err
+	}
+	if cycle.IsOpen(now) {
+		return nil
+	}
+
+	reopening, err := cycleRepo.GetReopening(cycle.ID, internID)
+	if err == domain.ErrReopeningNotFound {
+		return domain.ErrReviewCycleClosed
+	}
+	if err != nil {
+		return err
+	}
+	if now.After(reopening.Deadline) {
+		return domain.ErrReviewCycleClosed
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+const (
+	minRubricScale = 2
+	maxRubricScale = 10
+)
+
+type reviewRubricUsecase struct {
+	rubricRepo     domain.ReviewRubricRepository
+	reviewRepo     domain.MentorReviewRepository
+	assessmentRepo domain.SelfAssessmentRepository
+	internRepo     domain.InternRepository
+}
+
+// NewReviewRubricUsecase creates a new review rubric usecase
+func NewReviewRubricUsecase(rubricRepo domain.ReviewRubricRepository, reviewRepo domain.MentorReviewRepository, assessmentRepo domain.SelfAssessmentRepository, internRepo domain.InternRepository) domain.ReviewRubricUsecase {
+	return &reviewRubricUsecase{
+		rubricRepo:     rubricRepo,
+		reviewRepo:     reviewRepo,
+		assessmentRepo: assessmentRepo,
+		internRepo:     internRepo,
+	}
+}
+
+// CreateRubric publishes the next rubric version of a division, effective from a YYYY-MM period.
+// Leave the division empty for the rubric shared by every division.
+func (u *reviewRubricUsecase) CreateRubric(actorID uint, name, division, effectivePeriod string, scale int, criteria []domain.RubricCriterionInput) (*domain.ReviewRubric, error) {
+	if _, err := time.ParseInLocation("2006-01", effectivePeriod, time.Local); err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	if strings.TrimSpace(name) == "" || scale < minRubricScale || scale > maxRubricScale || len(criteria) == 0 {
+		return nil, domain.ErrInvalidRubric
+	}
+
+	rubricCriteria := make([]domain.RubricCriterion, 0, len(criteria))
+	for i, criterion := range criteria {
+		if strings.TrimSpace(criterion.Name) == "" || criterion.Weight <= 0 {
+			return nil, domain.ErrInvalidRubric
+		}
+		rubricCriteria = append(rubricCriteria, domain.RubricCriterion{
+			Name:        strings.TrimSpace(criterion.Name),
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+			Position:    i + 1,
+		})
+	}
+
+	version, err := u.rubricRepo.LatestVersion(division)
+	if err != nil {
+		return nil, err
+	}
+
+	rubric := &domain.ReviewRubric{
+		Name:            strings.TrimSpace(name),
+		Division:        division,
+		Version:         version + 1,
+		EffectivePeriod: effectivePeriod,
+		Scale:           scale,
+		Criteria:        rubricCriteria,
+		CreatedByID:     &actorID,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := u.rubricRepo.Create(rubric); err != nil {
+		return nil, err
+	}
+
+	return u.rubricRepo.GetByID(rubric.ID)
+}
+
+// GetRubrics gets every rubric version, optionally only those of one division
+func (u *reviewRubricUsecase) GetRubrics(division string) ([]domain.ReviewRubric, error) {
+	return u.rubricRepo.GetAll(division)
+}
+
+// GetRubricByID gets a rubric version by ID
+func (u *reviewRubricUsecase) GetRubricByID(id uint) (*domain.ReviewRubric, error) {
+	return u.rubricRepo.GetByID(id)
+}
+
+// GetRubricForIntern gets the rubric a review of the intern for the period would be scored against
+func (u *reviewRubricUsecase) GetRubricForIntern(actorID, actorRoleID, internID uint, period string) (*domain.ReviewRubric, error) {
+	if _, err := time.ParseInLocation("2006-01", period, time.Local); err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return nil, err
+	}
+
+	profile, err := u.internRepo.GetByUserID(internID)
+	if err != nil {
+		return nil, err
+	}
+
+	return periodRubric(u.rubricRepo, u.reviewRepo, u.assessmentRepo, profile, period)
+}
+
+// DeleteRubric deletes a rubric version no review, self-assessment or peer feedback round uses yet
+func (u *reviewRubricUsecase) DeleteRubric(id uint) error {
+	if _, err := u.rubricRepo.GetByID(id); err != nil {
+		return err
+	}
+
+	used, err := u.rubricRepo.IsUsed(id)
+	if err != nil {
+		return err
+	}
+	if used {
+		return domain.ErrRubricInUse
+	}
+
+	return u.rubricRepo.Delete(id)
+}
+
+// periodRubric gets the rubric the intern's review and self-assessment of a period are scored
+// against. Whichever of the two is written first pins the rubric version, so they stay comparable
+// when HR publishes a new version in between.
+func periodRubric(rubricRepo domain.ReviewRubricRepository, reviewRepo domain.MentorReviewRepository, assessmentRepo domain.SelfAssessmentRepository, profile *domain.InternProfile, period string) (*domain.ReviewRubric, error) {
+	review, err := reviewRepo.GetByInternAndPeriod(profile.UserID, period)
+	if err != nil && err != domain.ErrMentorReviewNotFound {
+		return nil, err
+	}
+	if review != nil && review.RubricID != nil {
+		return rubricRepo.GetByID(*review.RubricID)
+	}
+
+	assessment, err := assessmentRepo.GetByInternAndPeriod(profile.UserID, period)
+	if err != nil && err != domain.ErrSelfAssessmentNotFound {
+		return nil, err
+	}
+	if assessment != nil {
+		return rubricRepo.GetByID(assessment.RubricID)
+	}
+
+	return rubricRepo.FindFor(profile.Division, period)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"testing"
+
+	"backend-dashboard/internal/domain"
+)
+
+func TestPeriodRubric(t *testing.T) {
+	const internID = 10
+	rubricID := func(id uint) *uint { return &id }
+	// Version 2 of the engineering rubric takes effect in February
+	rubrics := []domain.ReviewRubric{
+		{ID: 1, Version: 1},
+		{ID: 2, Division: "Engineering", Version: 1, EffectivePeriod: "2026-01"},
+		{ID: 3, Division: "Engineering", Version: 2, EffectivePeriod: "2026-02"},
+	}
+	profile := &domain.InternProfile{UserID: internID, Division: "Engineering"}
+
+	tests := []struct {
+		name        string
+		reviews     []domain.MentorReview
+		assessments []domain.SelfAssessment
+		period      string
+		want        uint
+	}{
+		{
+			name:   "nothing written yet uses the rubric in effect",
+			period: "2026-02",
+			want:   3,
+		},
+		{
+			name:        "review follows the self-assessment",
+			assessments: []domain.SelfAssessment{{InternID: internID, Period: "2026-02", RubricID: 2}},
+			period:      "2026-02",
+			want:        2,
+		},
+		{
+			name:    "self-assessment follows the review",
+			reviews: []domain.MentorReview{{InternID: internID, Period: "2026-02", RubricID: rubricID(2)}},
+			period:  "2026-02",
+			want:    2,
+		},
+		{
+			name:        "review takes precedence over the self-assessment",
+			reviews:     []domain.MentorReview{{InternID: internID, Period: "2026-02", RubricID: rubricID(1)}},
+			assessments: []domain.SelfAssessment{{InternID: internID, Period: "2026-02", RubricID: 2}},
+			period:      "2026-02",
+			want:        1,
+		},
+		{
+			name:    "legacy review without a rubric",
+			reviews: []domain.MentorReview{{InternID: internID, Period: "2026-02"}},
+			period:  "2026-02",
+			want:    3,
+		},
+		{
+			name:        "another period is not pinned",
+			assessments: []domain.SelfAssessment{{InternID: internID, Period: "2026-01", RubricID: 2}},
+			period:      "2026-02",
+			want:        3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rubric, err := periodRubric(&fakeRubricRepo{rubrics: rubrics}, &fakeMentorReviewRepo{reviews: tt.reviews},
+				&fakeSelfAssessmentRepo{assessments: tt.assessments}, profile, tt.period)
+			if err != nil {
+				t.Fatalf("periodRubric() error = %v", err)
+			}
+			if rubric.ID != tt.want {
+				t.Errorf("periodRubric() = rubric %d, want %d", rubric.ID, tt.want)
+			}
+		})
+	}
+}
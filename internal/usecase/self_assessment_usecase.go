@@ -0,0 +1,325 @@
+package usecase
+
+import (
+	"math"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// selfAssessmentGapRatio is the difference between mentor and self score on a criterion, as a
+// share of the rubric scale, from which the comparison highlights it: 2 points on a 1-5 scale
+const selfAssessmentGapRatio = 0.4
+
+type selfAssessmentUsecase struct {
+	assessmentRepo domain.SelfAssessmentRepository
+	reviewRepo     domain.MentorReviewRepository
+	rubricRepo     domain.ReviewRubricRepository
+	cycleRepo      domain.ReviewCycleRepository
+	internRepo     domain.InternRepository
+}
+
+// NewSelfAssessmentUsecase creates a new self-assessment usecase
+func NewSelfAssessmentUsecase(assessmentRepo domain.SelfAssessmentRepository, reviewRepo domain.MentorReviewRepository, rubricRepo domain.ReviewRubricRepository, cycleRepo domain.ReviewCycleRepository, internRepo domain.InternRepository) domain.SelfAssessmentUsecase {
+	return &selfAssessmentUsecase{
+		assessmentRepo: assessmentRepo,
+		reviewRepo:     reviewRepo,
+		rubricRepo:     rubricRepo,
+		cycleRepo:      cycleRepo,
+		internRepo:     internRepo,
+	}
+}
+
+// CreateAssessment lets an intern start a draft self-assessment for a YYYY-MM period that
+// overlaps their internship, while the period's review cycle is open. It uses the rubric of
+// the mentor review if the PIC already started one, otherwise the rubric that applies to the
+// intern's division in that period.
+func (u *selfAssessmentUsecase) CreateAssessment(actorID, actorRoleID uint, period string, answers []domain.ReviewAnswerInput, notes string) (*domain.SelfAssessment, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	profile, err := u.internRepo.GetByUserID(actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	month, err := time.ParseInLocation("2006-01", period, time.Local)
+	if err != nil {
+		return nil, domain.ErrInvalidPeriod
+	}
+	if month.AddDate(0, 1, -1).Before(startOfDay(profile.StartDate)) || month.After(startOfDay(profile.EndDate)) {
+		return nil, domain.ErrOutsideInternshipPeriod
+	}
+
+	now := time.Now()
+	if err := reviewWindowOpen(u.cycleRepo, actorID, period, now); err != nil {
+		return nil, err
+	}
+
+	rubric, err := periodRubric(u.rubricRepo, u.reviewRepo, u.assessmentRepo, profile, period)
+	if err != nil {
+		return nil, err
+	}
+	scored, score, err := scoreAnswers(rubric, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := &domain.SelfAssessment{
+		InternID:  actorID,
+		RubricID:  rubric.ID,
+		Answers:   selfAssessmentAnswers(scored),
+		Score:     score,
+		Notes:     notes,
+		Period:    period,
+		Status:    domain.ReviewDraft,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := u.assessmentRepo.Create(assessment); err != nil {
+		return nil, err
+	}
+
+	return u.assessmentRepo.GetByID(assessment.ID)
+}
+
+// UpdateAssessment replaces the answers and notes of a draft self-assessment
+func (u *selfAssessmentUsecase) UpdateAssessment(actorID, actorRoleID, id uint, answers []domain.ReviewAnswerInput, notes string) (*domain.SelfAssessment, error) {
+	assessment, err := u.editableAssessment(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	scored, score, err := scoreAnswers(assessment.Rubric, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment.Answers = selfAssessmentAnswers(scored)
+	assessment.Score = score
+	assessment.Notes = notes
+	assessment.UpdatedAt = time.Now()
+
+	if err := u.assessmentRepo.ReplaceAnswers(assessment); err != nil {
+		return nil, err
+	}
+
+	return u.assessmentRepo.GetByID(assessment.ID)
+}
+
+// SubmitAssessment makes a draft self-assessment read-only
+func (u *selfAssessmentUsecase) SubmitAssessment(actorID, actorRoleID, id uint) (*domain.SelfAssessment, error) {
+	assessment, err := u.editableAssessment(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	assessment.Status = domain.ReviewSubmitted
+	assessment.SubmittedAt = &now
+	assessment.UpdatedAt = now
+
+	if err := u.assessmentRepo.Update(assessment); err != nil {
+		return nil, err
+	}
+
+	return u.assessmentRepo.GetByID(assessment.ID)
+}
+
+// GetAssessments lists self-assessments visible to the actor.
+// Interns see their own including drafts, HR sees every submitted one and PICs only
+// see those of their interns for periods they already submitted a review for.
+func (u *selfAssessmentUsecase) GetAssessments(actorID, actorRoleID uint, filter domain.SelfAssessmentFilter, page, limit int) ([]domain.SelfAssessment, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		filter.Status = domain.ReviewSubmitted
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+		filter.Status = domain.ReviewSubmitted
+		filter.MentorReviewed = true
+	case actorRoleID == domain.RoleIntern:
+		filter.InternID = actorID
+	default:
+		return nil, 0, domain.ErrForbidden
+	}
+
+	return u.assessmentRepo.GetAll(filter, page, limit)
+}
+
+// GetAssessmentByID gets a self-assessment visible to the actor
+func (u *selfAssessmentUsecase) GetAssessmentByID(actorID, actorRoleID, id uint) (*domain.SelfAssessment, error) {
+	assessment, err := u.assessmentRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorRoleID == domain.RoleIntern {
+		if assessment.InternID != actorID {
+			return nil, domain.ErrSelfAssessmentNotFound
+		}
+		return assessment, nil
+	}
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, assessment.InternID); err != nil {
+		return nil, err
+	}
+	// Drafts stay private to the intern
+	if !assessment.IsSubmitted() {
+		return nil, domain.ErrSelfAssessmentNotFound
+	}
+	if actorRoleID == domain.RolePIC {
+		if err := u.requireSubmittedReview(assessment.InternID, assessment.Period); err != nil {
+			return nil, err
+		}
+	}
+
+	return assessment, nil
+}
+
+// CompareWithReview puts a mentor review next to the intern's submitted self-assessment.
+// The PIC only gets the comparison once their review is submitted, so the self-assessment
+// cannot anchor it; the intern sees it once the review is visible to them.
+func (u *selfAssessmentUsecase) CompareWithReview(actorID, actorRoleID, reviewID uint) (*domain.ReviewComparison, error) {
+	review, err := u.reviewRepo.GetByID(reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorRoleID == domain.RoleIntern {
+		if review.InternID != actorID || !review.IsSubmitted() {
+			return nil, domain.ErrMentorReviewNotFound
+		}
+	} else {
+		if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, review.InternID); err != nil {
+			return nil, err
+		}
+		if actorRoleID == domain.RolePIC && !review.IsSubmitted() {
+			return nil, domain.ErrSelfAssessmentHidden
+		}
+	}
+
+	assessment, err := u.assessmentRepo.GetByInternAndPeriod(review.InternID, review.Period)
+	if err != nil && err != domain.ErrSelfAssessmentNotFound {
+		return nil, err
+	}
+	if assessment != nil && !assessment.IsSubmitted() {
+		assessment = nil
+	}
+
+	return compareReview(review, assessment), nil
+}
+
+// requireSubmittedReview hides self-assessments from the PIC until the review of the period is submitted
+func (u *selfAssessmentUsecase) requireSubmittedReview(internID uint, period string) error {
+	review, err := u.reviewRepo.GetByInternAndPeriod(internID, period)
+	if err == domain.ErrMentorReviewNotFound {
+		return domain.ErrSelfAssessmentHidden
+	}
+	if err != nil {
+		return err
+	}
+	if !review.IsSubmitted() {
+		return domain.ErrSelfAssessmentHidden
+	}
+	return nil
+}
+
+// editableAssessment loads the actor's own self-assessment and checks it is still a draft
+// within an open review window
+func (u *selfAssessmentUsecase) editableAssessment(actorID, actorRoleID, id uint) (*domain.SelfAssessment, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	assessment, err := u.assessmentRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if assessment.InternID != actorID {
+		return nil, domain.ErrSelfAssessmentNotFound
+	}
+	if assessment.IsSubmitted() {
+		return nil, domain.ErrSelfAssessmentSubmitted
+	}
+	if err := reviewWindowOpen(u.cycleRepo, actorID, assessment.Period, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return assessment, nil
+}
+
+// selfAssessmentAnswers converts scored rubric answers into self-assessment answers
+func selfAssessmentAnswers(scored []domain.ReviewAnswer) []domain.SelfAssessmentAnswer {
+	answers := make([]domain.SelfAssessmentAnswer, 0, len(scored))
+	for _, answer := range scored {
+		answers = append(answers, domain.SelfAssessmentAnswer{
+			CriterionID: answer.CriterionID,
+			Score:       answer.Score,
+			Comment:     answer.Comment,
+		})
+	}
+	return answers
+}
+
+// compareReview lines up mentor and self scores per criterion of the review's rubric.
+// Legacy reviews without a rubric fall back to the self-assessment's criteria.
+func compareReview(review *domain.MentorReview, assessment *domain.SelfAssessment) *domain.ReviewComparison {
+	comparison := &domain.ReviewComparison{
+		Review:         review,
+		SelfAssessment: assessment,
+		Criteria:       []domain.CriterionComparison{},
+	}
+
+	rubric := review.Rubric
+	selfScores := make(map[uint]int)
+	if assessment != nil {
+		if rubric == nil {
+			rubric = assessment.Rubric
+		}
+		for _, answer := range assessment.Answers {
+			selfScores[answer.CriterionID] = answer.Score
+		}
+		if review.RubricID != nil {
+			gap := review.Score - assessment.Score
+			comparison.ScoreGap = &gap
+		}
+	}
+	if rubric == nil {
+		return comparison
+	}
+
+	mentorScores := make(map[uint]int, len(review.Answers))
+	for _, answer := range review.Answers {
+		mentorScores[answer.CriterionID] = answer.Score
+	}
+
+	for _, criterion := range rubric.Criteria {
+		row := domain.CriterionComparison{
+			CriterionID: criterion.ID,
+			Name:        criterion.Name,
+			Weight:      criterion.Weight,
+		}
+		if score, ok := mentorScores[criterion.ID]; ok {
+			row.MentorScore = &score
+		}
+		if score, ok := selfScores[criterion.ID]; ok {
+			row.SelfScore = &score
+		}
+		if row.MentorScore != nil && row.SelfScore != nil {
+			gap := *row.MentorScore - *row.SelfScore
+			row.Gap = &gap
+			row.Highlighted = math.Abs(float64(gap)) >= selfAssessmentGapRatio*float64(rubric.Scale)
+		}
+		comparison.Criteria = append(comparison.Criteria, row)
+	}
+
+	return comparison
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+)
+
+// purposeKey derives the signing key of single-purpose tokens from the JWT secret,
+// so a link or kiosk token can never pass as a login token and vice versa
+func purposeKey(jwtSecret, purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(jwtSecret))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"backend-dashboard/internal/domain"
+	"backend-dashboard/pkg/storage"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// downloadLinkTTL is how long a generated attachment download link stays valid
+const downloadLinkTTL = 5 * time.Minute
+
+// downloadPurpose identifies attachment download tokens and derives their signing key
+const downloadPurpose = "attachment_download"
+
+type taskAttachmentUsecase struct {
+	attachmentRepo domain.TaskAttachmentRepository
+	taskRepo       domain.TaskRepository
+	internRepo     domain.InternRepository
+	storage        storage.Storage
+	maxSize        int64
+	jwtSecret      string
+}
+
+// NewTaskAttachmentUsecase creates a new task attachment usecase
+func NewTaskAttachmentUsecase(attachmentRepo domain.TaskAttachmentRepository, taskRepo domain.TaskRepository, internRepo domain.InternRepository, store storage.Storage, maxSize int64, jwtSecret string) domain.TaskAttachmentUsecase {
+	return &taskAttachmentUsecase{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		internRepo:     internRepo,
+		storage:        store,
+		maxSize:        maxSize,
+		jwtSecret:      jwtSecret,
+	}
+}
+
+// Upload stores a file against a task after checking its size and content type
+func (u *taskAttachmentUsecase) Upload(actorID, actorRoleID, taskID uint, fileName string, size int64, content io.Reader) (*domain.TaskAttachment, error) {
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTaskView(u.internRepo, actorID, actorRoleID, task); err != nil {
+		return nil, err
+	}
+
+	if size > u.maxSize {
+		return nil, domain.ErrFileTooLarge
+	}
+
+	key, err := uploadKey(fmt.Sprintf("tasks/%d", task.ID), fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := storeUpload(u.storage, key, content, u.maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &domain.TaskAttachment{
+		TaskID:      task.ID,
+		UploaderID:  actorID,
+		FileName:    filepath.Base(fileName),
+		ContentType: stored.ContentType,
+		Size:        stored.Size,
+		Checksum:    stored.Checksum,
+		StorageKey:  key,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := u.attachmentRepo.Create(attachment); err != nil {
+		u.storage.Delete(key)
+		return nil, err
+	}
+
+	return u.attachmentRepo.GetByID(attachment.ID)
+}
+
+// GetAttachments lists the attachments of a task the actor can see
+func (u *taskAttachmentUsecase) GetAttachments(actorID, actorRoleID, taskID uint) ([]domain.TaskAttachment, error) {
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTaskView(u.internRepo, actorID, actorRoleID, task); err != nil {
+		return nil, err
+	}
+
+	return u.attachmentRepo.GetByTaskID(taskID)
+}
+
+// CreateDownloadToken issues a short-lived signed token for downloading an attachment.
+// The token is bound to the attachment so it can be used in a plain link.
+func (u *taskAttachmentUsecase) CreateDownloadToken(actorID, actorRoleID, taskID, attachmentID uint) (string, time.Time, error) {
+	attachment, err := u.taskAttachment(actorID, actorRoleID, taskID, attachmentID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(downloadLinkTTL)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"purpose":       downloadPurpose,
+		"attachment_id": attachment.ID,
+		"exp":           expiresAt.Unix(),
+	})
+
+	tokenString, err := token.SignedString(purposeKey(u.jwtSecret, downloadPurpose))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+// OpenDownload validates a download token and opens the attachment content
+func (u *taskAttachmentUsecase) OpenDownload(attachmentID uint, tokenString string) (*domain.TaskAttachment, io.ReadCloser, error) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return purposeKey(u.jwtSecret, downloadPurpose), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, nil, domain.ErrInvalidDownloadLink
+	}
+
+	id, _ := claims["attachment_id"].(float64)
+	if claims["purpose"] != downloadPurpose || uint(id) != attachmentID {
+		return nil, nil, domain.ErrInvalidDownloadLink
+	}
+
+	attachment, err := u.attachmentRepo.GetByID(attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := u.storage.Open(attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, file, nil
+}
+
+// DeleteAttachment deletes an attachment. Only the uploader may delete it.
+func (u *taskAttachmentUsecase) DeleteAttachment(actorID, actorRoleID, taskID, attachmentID uint) error {
+	attachment, err := u.taskAttachment(actorID, actorRoleID, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment.UploaderID != actorID {
+		return domain.ErrForbidden
+	}
+
+	if err := u.attachmentRepo.Delete(attachment.ID); err != nil {
+		return err
+	}
+
+	return u.storage.Delete(attachment.StorageKey)
+}
+
+// taskAttachment loads an attachment of the given task visible to the actor
+func (u *taskAttachmentUsecase) taskAttachment(actorID, actorRoleID, taskID, attachmentID uint) (*domain.TaskAttachment, error) {
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTaskView(u.internRepo, actorID, actorRoleID, task); err != nil {
+		return nil, err
+	}
+
+	attachment, err := u.attachmentRepo.GetByID(attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TaskID != task.ID {
+		return nil, domain.ErrAttachmentNotFound
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// mentionPattern matches @username mentions in a comment body
+var mentionPattern = regexp.MustCompile(`@([A-Za-z0-9_.]+)`)
+
+type taskCommentUsecase struct {
+	commentRepo domain.TaskCommentRepository
+	taskRepo    domain.TaskRepository
+	internRepo  domain.InternRepository
+	userRepo    domain.UserRepository
+}
+
+// NewTaskCommentUsecase creates a new task comment usecase
+func NewTaskCommentUsecase(commentRepo domain.TaskCommentRepository, taskRepo domain.TaskRepository, internRepo domain.InternRepository, userRepo domain.UserRepository) domain.TaskCommentUsecase {
+	return &taskCommentUsecase{
+		commentRepo: commentRepo,
+		taskRepo:    taskRepo,
+		internRepo:  internRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// AddComment posts a comment on a task the actor can see
+func (u *taskCommentUsecase) AddComment(actorID, actorRoleID, taskID uint, body string) (*domain.TaskComment, error) {
+	task, err := u.visibleTask(actorID, actorRoleID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := u.resolveMentions(task, actorID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	comment := &domain.TaskComment{
+		TaskID:    task.ID,
+		AuthorID:  actorID,
+		Body:      body,
+		Mentions:  mentions,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := u.commentRepo.Create(comment); err != nil {
+		return nil, err
+	}
+
+	// Reload with author and mentioned users
+	return u.commentRepo.GetByID(comment.ID)
+}
+
+// GetComments gets the discussion thread of a task
+func (u *taskCommentUsecase) GetComments(actorID, actorRoleID, taskID uint) ([]domain.TaskComment, error) {
+	if _, err := u.visibleTask(actorID, actorRoleID, taskID); err != nil {
+		return nil, err
+	}
+
+	return u.commentRepo.GetByTaskID(taskID)
+}
+
+// UpdateComment edits a comment. Only the author may edit.
+func (u *taskCommentUsecase) UpdateComment(actorID, actorRoleID, taskID, commentID uint, body string) (*domain.TaskComment, error) {
+	task, comment, err := u.ownComment(actorID, actorRoleID, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := u.resolveMentions(task, actorID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	comment.Body = body
+	comment.EditedAt = &now
+	comment.UpdatedAt = now
+
+	if err := u.commentRepo.Update(comment, mentions); err != nil {
+		return nil, err
+	}
+
+	return u.commentRepo.GetByID(comment.ID)
+}
+
+// DeleteComment deletes a comment. Only the author may delete.
+func (u *taskCommentUsecase) DeleteComment(actorID, actorRoleID, taskID, commentID uint) error {
+	if _, _, err := u.ownComment(actorID, actorRoleID, taskID, commentID); err != nil {
+		return err
+	}
+
+	return u.commentRepo.Delete(commentID)
+}
+
+// GetMentions lists comments that mention the actor
+func (u *taskCommentUsecase) GetMentions(actorID uint, page, limit int) ([]domain.TaskComment, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	return u.commentRepo.GetByMentionedUser(actorID, page, limit)
+}
+
+// visibleTask loads a task and checks the actor may take part in its thread
+func (u *taskCommentUsecase) visibleTask(actorID, actorRoleID, taskID uint) (*domain.Task, error) {
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTaskView(u.internRepo, actorID, actorRoleID, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// ownComment loads a comment of the given task written by the actor
+func (u *taskCommentUsecase) ownComment(actorID, actorRoleID, taskID, commentID uint) (*domain.Task, *domain.TaskComment, error) {
+	task, err := u.visibleTask(actorID, actorRoleID, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment, err := u.commentRepo.GetByID(commentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comment.TaskID != task.ID {
+		return nil, nil, domain.ErrCommentNotFound
+	}
+	if comment.AuthorID != actorID {
+		return nil, nil, domain.ErrForbidden
+	}
+
+	return task, comment, nil
+}
+
+// resolveMentions turns @username mentions into mention rows.
+// Users who cannot see the task (anyone but the intern, their PIC and HR) are ignored.
+func (u *taskCommentUsecase) resolveMentions(task *domain.Task, actorID uint, body string) ([]domain.TaskCommentMention, error) {
+	profile, err := u.internRepo.GetByUserID(task.InternID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []domain.TaskCommentMention
+	seen := make(map[uint]bool)
+	now := time.Now()
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		username := strings.TrimRight(match[1], ".")
+		user, err := u.userRepo.GetByUsername(username)
+		if err != nil {
+			if err == domain.ErrUserNotFound {
+				continue
+			}
+			return nil, err
+		}
+
+		if user.ID == actorID || seen[user.ID] {
+			continue
+		}
+		if user.ID != task.InternID && user.ID != profile.PICID && !domain.IsHROrAbove(user.RoleID) {
+			continue
+		}
+
+		seen[user.ID] = true
+		mentions = append(mentions, domain.TaskCommentMention{
+			UserID:    user.ID,
+			CreatedAt: now,
+		})
+	}
+
+	return mentions, nil
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// recurrenceLookaheadDays is how far ahead occurrences are created so interns see upcoming work
+const recurrenceLookaheadDays = 7
+
+type taskRecurrenceUsecase struct {
+	recurrenceRepo domain.TaskRecurrenceRepository
+	templateRepo   domain.TaskTemplateRepository
+	taskRepo       domain.TaskRepository
+	internRepo     domain.InternRepository
+}
+
+// NewTaskRecurrenceUsecase creates a new task recurrence usecase
+func NewTaskRecurrenceUsecase(recurrenceRepo domain.TaskRecurrenceRepository, templateRepo domain.TaskTemplateRepository, taskRepo domain.TaskRepository, internRepo domain.InternRepository) domain.TaskRecurrenceUsecase {
+	return &taskRecurrenceUsecase{
+		recurrenceRepo: recurrenceRepo,
+		templateRepo:   templateRepo,
+		taskRepo:       taskRepo,
+		internRepo:     internRepo,
+	}
+}
+
+// CreateRecurrence adds a recurrence rule to a template for a batch and/or division.
+// Rules created by a PIC only ever reach that PIC's interns.
+func (u *taskRecurrenceUsecase) CreateRecurrence(actorID, actorRoleID, templateID uint, batch, division, frequency string, weekday, dayOfMonth *int, startDate time.Time, endDate *time.Time) (*domain.TaskRecurrence, error) {
+	if batch == "" && division == "" {
+		return nil, domain.ErrCohortRequired
+	}
+
+	switch frequency {
+	case domain.RecurrenceDaily:
+		weekday, dayOfMonth = nil, nil
+	case domain.RecurrenceWeekly:
+		if weekday == nil || *weekday < 0 || *weekday > 6 {
+			return nil, domain.ErrInvalidRecurrence
+		}
+		dayOfMonth = nil
+	case domain.RecurrenceMonthly:
+		if dayOfMonth == nil || *dayOfMonth < 1 || *dayOfMonth > 31 {
+			return nil, domain.ErrInvalidRecurrence
+		}
+		weekday = nil
+	default:
+		return nil, domain.ErrInvalidRecurrence
+	}
+
+	if endDate != nil && endDate.Before(startDate) {
+		return nil, domain.ErrInvalidRecurrence
+	}
+
+	if _, err := u.templateRepo.GetByID(templateID); err != nil {
+		return nil, err
+	}
+
+	var picID *uint
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+	case actorRoleID == domain.RolePIC:
+		picID = &actorID
+	default:
+		return nil, domain.ErrForbidden
+	}
+
+	now := time.Now()
+	recurrence := &domain.TaskRecurrence{
+		TemplateID:  templateID,
+		Batch:       batch,
+		Division:    division,
+		PICID:       picID,
+		Frequency:   frequency,
+		Weekday:     weekday,
+		DayOfMonth:  dayOfMonth,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Active:      true,
+		CreatedByID: actorID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := u.recurrenceRepo.Create(recurrence); err != nil {
+		return nil, err
+	}
+
+	return u.recurrenceRepo.GetByID(recurrence.ID)
+}
+
+// GetRecurrences lists the recurrence rules of a template
+func (u *taskRecurrenceUsecase) GetRecurrences(templateID uint) ([]domain.TaskRecurrence, error) {
+	if _, err := u.templateRepo.GetByID(templateID); err != nil {
+		return nil, err
+	}
+
+	return u.recurrenceRepo.GetByTemplateID(templateID)
+}
+
+// DeactivateRecurrence stops a rule from producing new occurrences. Existing tasks are kept.
+func (u *taskRecurrenceUsecase) DeactivateRecurrence(actorID, actorRoleID, templateID, id uint) error {
+	recurrence, err := u.recurrenceRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if recurrence.TemplateID != templateID {
+		return domain.ErrRecurrenceNotFound
+	}
+	if !domain.IsHROrAbove(actorRoleID) && recurrence.CreatedByID != actorID {
+		return domain.ErrForbidden
+	}
+
+	recurrence.Active = false
+	recurrence.UpdatedAt = time.Now()
+	return u.recurrenceRepo.Update(recurrence)
+}
+
+// GenerateOccurrences creates the tasks of every active rule from today up to the lookahead horizon.
+// Existing occurrences are skipped by the unique (recurrence, intern, date) index, so it is safe
+// to run repeatedly and after restarts. Occurrences whose task was deleted are not recreated.
+func (u *taskRecurrenceUsecase) GenerateOccurrences(now time.Time) error {
+	recurrences, err := u.recurrenceRepo.GetActive()
+	if err != nil {
+		return err
+	}
+
+	today := startOfDay(now)
+	horizon := today.AddDate(0, 0, recurrenceLookaheadDays)
+
+	var errs []error
+	for i := range recurrences {
+		recurrence := &recurrences[i]
+
+		// Rules past their end date have nothing left to produce
+		if recurrence.EndDate != nil && startOfDay(*recurrence.EndDate).Before(today) {
+			recurrence.Active = false
+			recurrence.UpdatedAt = now
+			if err := u.recurrenceRepo.Update(recurrence); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		tasks, err := u.occurrences(recurrence, today, horizon, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurrence %d: %w", recurrence.ID, err))
+			continue
+		}
+
+		created, err := u.taskRepo.CreateOccurrences(tasks)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurrence %d: %w", recurrence.ID, err))
+			continue
+		}
+		if created > 0 {
+			log.Printf("Recurrence %d: created %d task(s)", recurrence.ID, created)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// occurrences builds the tasks a rule should have between today and the horizon
+func (u *taskRecurrenceUsecase) occurrences(recurrence *domain.TaskRecurrence, today, horizon, now time.Time) ([]domain.Task, error) {
+	var picID uint
+	if recurrence.PICID != nil {
+		picID = *recurrence.PICID
+	}
+
+	profiles, err := u.internRepo.FindByCohort(recurrence.Batch, recurrence.Division, picID)
+	if err != nil {
+		return nil, err
+	}
+
+	skippedOccurrences, err := u.recurrenceRepo.GetSkipped(recurrence.ID, today, horizon)
+	if err != nil {
+		return nil, err
+	}
+	skipped := make(map[string]bool, len(skippedOccurrences))
+	for _, occurrence := range skippedOccurrences {
+		skipped[fmt.Sprintf("%d/%s", occurrence.InternID, occurrence.OccurrenceDate.Format("2006-01-02"))] = true
+	}
+
+	var tasks []domain.Task
+	for _, profile := range profiles {
+		from := latestOf(today, startOfDay(recurrence.StartDate), startOfDay(profile.StartDate))
+
+		until := startOfDay(profile.EndDate)
+		if recurrence.EndDate != nil {
+			until = earliestOf(until, startOfDay(*recurrence.EndDate))
+		}
+		until = earliestOf(until, horizon)
+
+		for day := from; !day.After(until); day = day.AddDate(0, 0, 1) {
+			if !recurrence.OccursOn(day) || skipped[fmt.Sprintf("%d/%s", profile.UserID, day.Format("2006-01-02"))] {
+				continue
+			}
+
+			occurrenceDate := day
+			tasks = append(tasks, domain.Task{
+				InternID:       profile.UserID,
+				AssignedByID:   &recurrence.CreatedByID,
+				TemplateID:     &recurrence.TemplateID,
+				RecurrenceID:   &recurrence.ID,
+				OccurrenceDate: &occurrenceDate,
+				Title:          fmt.Sprintf("%s (%s)", recurrence.Template.Title, day.Format("2006-01-02")),
+				Description:    recurrence.Template.Description,
+				Status:         domain.TaskStatusTodo,
+				Deadline:       endOfDay(day),
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			})
+		}
+	}
+
+	return tasks, nil
+}
+
+// startOfDay returns local midnight of the calendar day of t
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+func latestOf(first time.Time, rest ...time.Time) time.Time {
+	for _, t := range rest {
+		if t.After(first) {
+			first = t
+		}
+	}
+	return first
+}
+
+func earliestOf(first time.Time, rest ...time.Time) time.Time {
+	for _, t := range rest {
+		if t.Before(first) {
+			first = t
+		}
+	}
+	return first
+}
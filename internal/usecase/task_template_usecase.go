@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+type taskTemplateUsecase struct {
+	templateRepo domain.TaskTemplateRepository
+	taskRepo     domain.TaskRepository
+	internRepo   domain.InternRepository
+}
+
+// NewTaskTemplateUsecase creates a new task template usecase
+func NewTaskTemplateUsecase(templateRepo domain.TaskTemplateRepository, taskRepo domain.TaskRepository, internRepo domain.InternRepository) domain.TaskTemplateUsecase {
+	return &taskTemplateUsecase{
+		templateRepo: templateRepo,
+		taskRepo:     taskRepo,
+		internRepo:   internRepo,
+	}
+}
+
+// CreateTemplate creates a reusable task template
+func (u *taskTemplateUsecase) CreateTemplate(actorID uint, title, description string, deadlineOffsetDays int) (*domain.TaskTemplate, error) {
+	now := time.Now()
+	template := &domain.TaskTemplate{
+		Title:              title,
+		Description:        description,
+		DeadlineOffsetDays: deadlineOffsetDays,
+		CreatedByID:        actorID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if err := u.templateRepo.Create(template); err != nil {
+		return nil, err
+	}
+
+	return u.templateRepo.GetByID(template.ID)
+}
+
+// GetTemplateByID gets a task template by ID
+func (u *taskTemplateUsecase) GetTemplateByID(id uint) (*domain.TaskTemplate, error) {
+	return u.templateRepo.GetByID(id)
+}
+
+// GetAllTemplates gets all task templates with pagination
+func (u *taskTemplateUsecase) GetAllTemplates(page, limit int) ([]domain.TaskTemplate, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	return u.templateRepo.GetAll(page, limit)
+}
+
+// UpdateTemplate updates a template. Only its creator or HR may change it.
+func (u *taskTemplateUsecase) UpdateTemplate(actorID, actorRoleID, id uint, title, description string, deadlineOffsetDays int) (*domain.TaskTemplate, error) {
+	template, err := u.ownedTemplate(actorID, actorRoleID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Title = title
+	template.Description = description
+	template.DeadlineOffsetDays = deadlineOffsetDays
+	template.UpdatedAt = time.Now()
+
+	if err := u.templateRepo.Update(template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// DeleteTemplate deletes a template. Only its creator or HR may delete it.
+func (u *taskTemplateUsecase) DeleteTemplate(actorID, actorRoleID, id uint) error {
+	if _, err := u.ownedTemplate(actorID, actorRoleID, id); err != nil {
+		return err
+	}
+
+	return u.templateRepo.Delete(id)
+}
+
+// AssignTemplate creates a task from the template for every intern in the batch and/or division.
+// PICs only reach their own interns. Deadlines are relative to each intern's StartDate.
+func (u *taskTemplateUsecase) AssignTemplate(actorID, actorRoleID, id uint, batch, division string) ([]domain.Task, error) {
+	if batch == "" && division == "" {
+		return nil, domain.ErrCohortRequired
+	}
+
+	template, err := u.templateRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var picID uint
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+	case actorRoleID == domain.RolePIC:
+		picID = actorID
+	default:
+		return nil, domain.ErrForbidden
+	}
+
+	profiles, err := u.internRepo.FindByCohort(batch, division, picID)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, domain.ErrNoMatchingInterns
+	}
+
+	now := time.Now()
+	tasks := make([]domain.Task, len(profiles))
+	for i, profile := range profiles {
+		tasks[i] = domain.Task{
+			InternID:     profile.UserID,
+			AssignedByID: &actorID,
+			TemplateID:   &template.ID,
+			Title:        template.Title,
+			Description:  template.Description,
+			Status:       domain.TaskStatusTodo,
+			Deadline:     endOfDay(profile.StartDate.AddDate(0, 0, template.DeadlineOffsetDays)),
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+	}
+
+	if err := u.taskRepo.CreateBatch(tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// ownedTemplate loads a template the actor may modify
+func (u *taskTemplateUsecase) ownedTemplate(actorID, actorRoleID, id uint) (*domain.TaskTemplate, error) {
+	template, err := u.templateRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !domain.IsHROrAbove(actorRoleID) && template.CreatedByID != actorID {
+		return nil, domain.ErrForbidden
+	}
+
+	return template, nil
+}
+
+// endOfDay returns the last second of the calendar day of t, in local time
+func endOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 23, 59, 59, 0, time.Local)
+}
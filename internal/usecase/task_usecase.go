@@ -0,0 +1,586 @@
+package usecase
+
+import (
+	"time"
+
+	"backend-dashboard/internal/domain"
+	"backend-dashboard/pkg/storage"
+)
+
+// taskTransitions lists the legal status moves per role
+var taskTransitions = map[uint]map[string][]string{
+	domain.RoleIntern: {
+		domain.TaskStatusTodo:       {domain.TaskStatusInProgress},
+		domain.TaskStatusInProgress: {domain.TaskStatusSubmitted},
+	},
+	domain.RolePIC: {
+		domain.TaskStatusSubmitted: {domain.TaskStatusDone, domain.TaskStatusInProgress},
+	},
+}
+
+type taskUsecase struct {
+	taskRepo   domain.TaskRepository
+	internRepo domain.InternRepository
+	storage    storage.Storage
+}
+
+// NewTaskUsecase creates a new task usecase
+func NewTaskUsecase(taskRepo domain.TaskRepository, internRepo domain.InternRepository, store storage.Storage) domain.TaskUsecase {
+	return &taskUsecase{
+		taskRepo:   taskRepo,
+		internRepo: internRepo,
+		storage:    store,
+	}
+}
+
+// CreateTask assigns a new task to an intern. PICs may only assign to their own interns.
+func (u *taskUsecase) CreateTask(actorID, actorRoleID, internID uint, title, description string, deadline time.Time) (*domain.Task, error) {
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	task := &domain.Task{
+		InternID:     internID,
+		AssignedByID: &actorID,
+		Title:        title,
+		Description:  description,
+		Status:       domain.TaskStatusTodo,
+		Deadline:     deadline,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := u.taskRepo.Create(task); err != nil {
+		return nil, err
+	}
+
+	// Reload with associations
+	return u.taskRepo.GetByID(task.ID)
+}
+
+// GetTaskByID gets a task the actor is allowed to see
+func (u *taskUsecase) GetTaskByID(actorID, actorRoleID, id uint) (*domain.Task, error) {
+	task, err := u.taskRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTaskView(u.internRepo, actorID, actorRoleID, task); err != nil {
+		return nil, err
+	}
+
+	task.IsOverdue = task.OverdueAt(time.Now())
+	return task, nil
+}
+
+// GetAllTasks lists tasks scoped to what the actor is allowed to see
+func (u *taskUsecase) GetAllTasks(actorID, actorRoleID uint, filter domain.TaskFilter, page, limit int) ([]domain.Task, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	switch {
+	case domain.IsHROrAbove(actorRoleID):
+		// HR sees everything
+	case actorRoleID == domain.RolePIC:
+		filter.PICID = actorID
+	case actorRoleID == domain.RoleIntern:
+		filter.InternID = actorID
+	default:
+		return nil, 0, domain.ErrForbidden
+	}
+
+	tasks, total, err := u.taskRepo.GetAll(filter, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	markOverdue(tasks, time.Now())
+	return tasks, total, nil
+}
+
+// GetOverdueTasks lists tasks past their deadline that have not been handed in
+func (u *taskUsecase) GetOverdueTasks(actorID, actorRoleID uint, filter domain.TaskFilter, page, limit int) ([]domain.Task, int64, error) {
+	if actorRoleID == domain.RoleIntern {
+		return nil, 0, domain.ErrForbidden
+	}
+
+	now := time.Now()
+	filter.OverdueAt = &now
+	return u.GetAllTasks(actorID, actorRoleID, filter, page, limit)
+}
+
+// UpdateTask updates the editable details of a task
+func (u *taskUsecase) UpdateTask(actorID, actorRoleID, id uint, title, description string, deadline time.Time) (*domain.Task, error) {
+	task, err := u.taskRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, task.InternID); err != nil {
+		return nil, err
+	}
+
+	task.Title = title
+	task.Description = description
+	task.Deadline = deadline
+	task.UpdatedAt = time.Now()
+
+	if err := u.taskRepo.Update(task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// DeleteTask deletes a task and the files attached to it
+func (u *taskUsecase) DeleteTask(actorID, actorRoleID, id uint) error {
+	task, err := u.taskRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, task.InternID); err != nil {
+		return err
+	}
+
+	storageKeys, err := u.taskRepo.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	// Files are only removed once the records are gone, so a failed delete loses nothing
+	for _, key := range storageKeys {
+		if err := u.storage.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangeStatus moves a task through the status workflow and records the transition
+func (u *taskUsecase) ChangeStatus(actorID, actorRoleID, id uint, status, reason string) (*domain.Task, error) {
+	task, err := u.taskRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Interns act on their own tasks, PICs on tasks of the interns they mentor
+	switch actorRoleID {
+	case domain.RoleIntern:
+		if task.InternID != actorID {
+			return nil, domain.ErrForbidden
+		}
+	case domain.RolePIC:
+		if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, task.InternID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, domain.ErrForbidden
+	}
+
+	if !isAllowedTransition(actorRoleID, task.Status, status) {
+		return nil, domain.ErrInvalidTaskTransition
+	}
+
+	// Sending work back to the intern must be explained
+	if task.Status == domain.TaskStatusSubmitted && status == domain.TaskStatusInProgress && reason == "" {
+		return nil, domain.ErrReasonRequired
+	}
+
+	// A task cannot be started while a task it depends on is unfinished
+	if task.Status == domain.TaskStatusTodo && status == domain.TaskStatusInProgress {
+		blockers, err := u.taskRepo.GetBlockers(task.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !allDone(blockers) {
+			return nil, domain.ErrTaskBlocked
+		}
+	}
+
+	// Parent tasks can only be handed in once every subtask is done
+	if (status == domain.TaskStatusSubmitted || status == domain.TaskStatusDone) && !allDone(task.Subtasks) {
+		return nil, domain.ErrSubtasksIncomplete
+	}
+
+	now := time.Now()
+	history := &domain.TaskStatusHistory{
+		TaskID:      task.ID,
+		FromStatus:  task.Status,
+		ToStatus:    status,
+		ChangedByID: actorID,
+		Reason:      reason,
+		CreatedAt:   now,
+	}
+
+	task.Status = status
+	task.UpdatedAt = now
+	switch status {
+	case domain.TaskStatusSubmitted:
+		task.SubmittedAt = &now
+	case domain.TaskStatusDone:
+		task.CompletedAt = &now
+		task.LatenessMinutes = latenessMinutes(task)
+	}
+
+	if err := u.taskRepo.UpdateStatus(task, history); err != nil {
+		return nil, err
+	}
+
+	if status == domain.TaskStatusDone && task.ParentID != nil {
+		if err := u.completeParent(*task.ParentID, actorID); err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
+
+// GetStatusHistory gets the transition history of a task the actor can see
+func (u *taskUsecase) GetStatusHistory(actorID, actorRoleID, id uint) ([]domain.TaskStatusHistory, error) {
+	if _, err := u.GetTaskByID(actorID, actorRoleID, id); err != nil {
+		return nil, err
+	}
+
+	return u.taskRepo.GetStatusHistory(id)
+}
+
+// GradeTask sets the quality score of a submitted or completed task.
+// Only the intern's assigned PIC may grade; earlier grades stay in the grade history.
+func (u *taskUsecase) GradeTask(actorID, actorRoleID, id uint, score int, feedback string) (*domain.Task, error) {
+	if score < 0 || score > 100 {
+		return nil, domain.ErrInvalidScore
+	}
+
+	task, err := u.taskRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorRoleID != domain.RolePIC {
+		return nil, domain.ErrForbidden
+	}
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, task.InternID); err != nil {
+		return nil, err
+	}
+
+	if task.Status != domain.TaskStatusSubmitted && task.Status != domain.TaskStatusDone {
+		return nil, domain.ErrTaskNotGradable
+	}
+
+	now := time.Now()
+	grade := &domain.TaskGrade{
+		TaskID:     task.ID,
+		Score:      score,
+		Feedback:   feedback,
+		GradedByID: actorID,
+		CreatedAt:  now,
+	}
+
+	task.QualityScore = &score
+	task.Feedback = feedback
+	task.GradedByID = &actorID
+	task.GradedAt = &now
+	task.UpdatedAt = now
+
+	if err := u.taskRepo.SaveGrade(task, grade); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// GetGrades gets the grade history of a task the actor can see
+func (u *taskUsecase) GetGrades(actorID, actorRoleID, id uint) ([]domain.TaskGrade, error) {
+	if _, err := u.GetTaskByID(actorID, actorRoleID, id); err != nil {
+		return nil, err
+	}
+
+	return u.taskRepo.GetGrades(id)
+}
+
+// CreateSubtask adds a child task for the same intern under an existing task
+func (u *taskUsecase) CreateSubtask(actorID, actorRoleID, parentID uint, title, description string, deadline time.Time) (*domain.Task, error) {
+	parent, err := u.taskRepo.GetByID(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, parent.InternID); err != nil {
+		return nil, err
+	}
+
+	// A completed parent would otherwise end up with unfinished children
+	if parent.Status == domain.TaskStatusDone {
+		return nil, domain.ErrInvalidDependency
+	}
+
+	now := time.Now()
+	task := &domain.Task{
+		InternID:     parent.InternID,
+		AssignedByID: &actorID,
+		ParentID:     &parent.ID,
+		Title:        title,
+		Description:  description,
+		Status:       domain.TaskStatusTodo,
+		Deadline:     deadline,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := u.taskRepo.Create(task); err != nil {
+		return nil, err
+	}
+
+	return u.taskRepo.GetByID(task.ID)
+}
+
+// GetSubtasks lists the direct children of a task the actor can see
+func (u *taskUsecase) GetSubtasks(actorID, actorRoleID, parentID uint) ([]domain.Task, error) {
+	if _, err := u.GetTaskByID(actorID, actorRoleID, parentID); err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetSubtasks(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	markOverdue(tasks, time.Now())
+	return tasks, nil
+}
+
+// AddDependency marks taskID as blocked by blockedByID. Both tasks must belong to the
+// same intern and the new link must not close a cycle.
+func (u *taskUsecase) AddDependency(actorID, actorRoleID, taskID, blockedByID uint) error {
+	if taskID == blockedByID {
+		return domain.ErrInvalidDependency
+	}
+
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return err
+	}
+	blocker, err := u.taskRepo.GetByID(blockedByID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, task.InternID); err != nil {
+		return err
+	}
+	if blocker.InternID != task.InternID {
+		return domain.ErrInvalidDependency
+	}
+
+	// A subtask blocked by one of its ancestors would wait on a parent that waits on it
+	cyclic, err := dependsOn(blocker.ID, task.ID, u.waitsOn)
+	if err != nil {
+		return err
+	}
+	if cyclic {
+		return domain.ErrDependencyCycle
+	}
+
+	return u.taskRepo.AddDependency(&domain.TaskDependency{
+		TaskID:      task.ID,
+		BlockedByID: blocker.ID,
+		CreatedByID: actorID,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// RemoveDependency deletes a "blocked by" link
+func (u *taskUsecase) RemoveDependency(actorID, actorRoleID, taskID, blockedByID uint) error {
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, task.InternID); err != nil {
+		return err
+	}
+
+	return u.taskRepo.RemoveDependency(taskID, blockedByID)
+}
+
+// GetDependencies lists the tasks blocking and blocked by a task
+func (u *taskUsecase) GetDependencies(actorID, actorRoleID, taskID uint) ([]domain.Task, []domain.Task, error) {
+	if _, err := u.GetTaskByID(actorID, actorRoleID, taskID); err != nil {
+		return nil, nil, err
+	}
+
+	blockedBy, err := u.taskRepo.GetBlockers(taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	blocking, err := u.taskRepo.GetBlocking(taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blockedBy, blocking, nil
+}
+
+// waitsOn lists the tasks a task cannot finish before: its blockers and its subtasks,
+// since a parent only completes once all of its subtasks are done
+func (u *taskUsecase) waitsOn(taskID uint) ([]uint, error) {
+	blockers, err := u.taskRepo.GetBlockers(taskID)
+	if err != nil {
+		return nil, err
+	}
+	subtasks, err := u.taskRepo.GetSubtasks(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(blockers)+len(subtasks))
+	for _, blocker := range blockers {
+		ids = append(ids, blocker.ID)
+	}
+	for _, subtask := range subtasks {
+		ids = append(ids, subtask.ID)
+	}
+	return ids, nil
+}
+
+// dependsOn reports whether from (transitively) waits on target
+func dependsOn(from, target uint, waitsOn func(taskID uint) ([]uint, error)) (bool, error) {
+	visited := map[uint]bool{}
+	stack := []uint{from}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == target {
+			return true, nil
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		next, err := waitsOn(current)
+		if err != nil {
+			return false, err
+		}
+		stack = append(stack, next...)
+	}
+
+	return false, nil
+}
+
+// completeParent marks a parent task done once all of its subtasks are done,
+// and continues up the tree
+func (u *taskUsecase) completeParent(parentID, actorID uint) error {
+	parent, err := u.taskRepo.GetByID(parentID)
+	if err != nil {
+		return err
+	}
+	if parent.Status == domain.TaskStatusDone || !allDone(parent.Subtasks) {
+		return nil
+	}
+
+	now := time.Now()
+	history := &domain.TaskStatusHistory{
+		TaskID:      parent.ID,
+		FromStatus:  parent.Status,
+		ToStatus:    domain.TaskStatusDone,
+		ChangedByID: actorID,
+		Reason:      "All subtasks completed",
+		CreatedAt:   now,
+	}
+
+	parent.Status = domain.TaskStatusDone
+	parent.CompletedAt = &now
+	parent.UpdatedAt = now
+	parent.LatenessMinutes = latenessMinutes(parent)
+
+	if err := u.taskRepo.UpdateStatus(parent, history); err != nil {
+		return err
+	}
+
+	if parent.ParentID != nil {
+		return u.completeParent(*parent.ParentID, actorID)
+	}
+	return nil
+}
+
+// allDone reports whether every task in the list is done
+func allDone(tasks []domain.Task) bool {
+	for _, task := range tasks {
+		if task.Status != domain.TaskStatusDone {
+			return false
+		}
+	}
+	return true
+}
+
+// latenessMinutes measures lateness from the intern's last submission so that
+// a slow review by the PIC does not count against the intern
+func latenessMinutes(task *domain.Task) int {
+	handedIn := task.CompletedAt
+	if task.SubmittedAt != nil {
+		handedIn = task.SubmittedAt
+	}
+	if handedIn == nil || !handedIn.After(task.Deadline) {
+		return 0
+	}
+	return int(handedIn.Sub(task.Deadline).Minutes())
+}
+
+// markOverdue fills the computed overdue flag of each task
+func markOverdue(tasks []domain.Task, now time.Time) {
+	for i := range tasks {
+		tasks[i].IsOverdue = tasks[i].OverdueAt(now)
+	}
+}
+
+// isAllowedTransition checks the transition table for the given role
+func isAllowedTransition(roleID uint, from, to string) bool {
+	for _, next := range taskTransitions[roleID][from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeInternManagement allows HR for any intern and a PIC only for interns they mentor
+func authorizeInternManagement(internRepo domain.InternRepository, actorID, actorRoleID, internID uint) error {
+	profile, err := internRepo.GetByUserID(internID)
+	if err != nil {
+		return err
+	}
+
+	if domain.IsHROrAbove(actorRoleID) {
+		return nil
+	}
+	if actorRoleID == domain.RolePIC && profile.PICID == actorID {
+		return nil
+	}
+	return domain.ErrForbidden
+}
+
+// authorizeTaskView allows the task's intern, the intern's PIC and HR
+func authorizeTaskView(internRepo domain.InternRepository, actorID, actorRoleID uint, task *domain.Task) error {
+	if domain.IsHROrAbove(actorRoleID) {
+		return nil
+	}
+	if actorRoleID == domain.RoleIntern {
+		if task.InternID == actorID {
+			return nil
+		}
+		return domain.ErrForbidden
+	}
+	if actorRoleID == domain.RolePIC {
+		return authorizeInternManagement(internRepo, actorID, actorRoleID, task.InternID)
+	}
+	return domain.ErrForbidden
+}
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+func TestIsAllowedTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		roleID uint
+		from   string
+		to     string
+		want   bool
+	}{
+		{"intern starts", domain.RoleIntern, domain.TaskStatusTodo, domain.TaskStatusInProgress, true},
+		{"intern submits", domain.RoleIntern, domain.TaskStatusInProgress, domain.TaskStatusSubmitted, true},
+		{"intern skips progress", domain.RoleIntern, domain.TaskStatusTodo, domain.TaskStatusSubmitted, false},
+		{"intern approves own work", domain.RoleIntern, domain.TaskStatusSubmitted, domain.TaskStatusDone, false},
+		{"intern reopens", domain.RoleIntern, domain.TaskStatusDone, domain.TaskStatusInProgress, false},
+		{"pic approves", domain.RolePIC, domain.TaskStatusSubmitted, domain.TaskStatusDone, true},
+		{"pic sends back", domain.RolePIC, domain.TaskStatusSubmitted, domain.TaskStatusInProgress, true},
+		{"pic starts", domain.RolePIC, domain.TaskStatusTodo, domain.TaskStatusInProgress, false},
+		{"pic reopens done", domain.RolePIC, domain.TaskStatusDone, domain.TaskStatusInProgress, false},
+		{"hr has no moves", domain.RoleHR, domain.TaskStatusSubmitted, domain.TaskStatusDone, false},
+		{"same status", domain.RoleIntern, domain.TaskStatusTodo, domain.TaskStatusTodo, false},
+		{"unknown status", domain.RoleIntern, domain.TaskStatusTodo, "archived", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAllowedTransition(tt.roleID, tt.from, tt.to); got != tt.want {
+				t.Errorf("isAllowedTransition(%d, %q, %q) = %v, want %v", tt.roleID, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangeStatus(t *testing.T) {
+	const (
+		internID      = 10
+		otherInternID = 11
+		picID         = 20
+		otherPICID    = 21
+	)
+	parentID := uint(5)
+	deadline := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		actorID     uint
+		actorRoleID uint
+		task        domain.Task
+		related     []domain.Task // subtasks and blockers of the task
+		blockers    []uint
+		status      string
+		reason      string
+		wantErr     error
+	}{
+		{
+			name:    "intern starts own task",
+			actorID: internID, actorRoleID: domain.RoleIntern,
+			task:   domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusTodo},
+			status: domain.TaskStatusInProgress,
+		},
+		{
+			name:    "intern starts another intern's task",
+			actorID: otherInternID, actorRoleID: domain.RoleIntern,
+			task:    domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusTodo},
+			status:  domain.TaskStatusInProgress,
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "intern marks own task done",
+			actorID: internID, actorRoleID: domain.RoleIntern,
+			task:    domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusSubmitted},
+			status:  domain.TaskStatusDone,
+			wantErr: domain.ErrInvalidTaskTransition,
+		},
+		{
+			name:    "pic approves",
+			actorID: picID, actorRoleID: domain.RolePIC,
+			task:   domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusSubmitted},
+			status: domain.TaskStatusDone,
+		},
+		{
+			name:    "pic of another intern",
+			actorID: otherPICID, actorRoleID: domain.RolePIC,
+			task:    domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusSubmitted},
+			status:  domain.TaskStatusDone,
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "pic sends back without reason",
+			actorID: picID, actorRoleID: domain.RolePIC,
+			task:    domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusSubmitted},
+			status:  domain.TaskStatusInProgress,
+			wantErr: domain.ErrReasonRequired,
+		},
+		{
+			name:    "pic sends back with reason",
+			actorID: picID, actorRoleID: domain.RolePIC,
+			task:   domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusSubmitted},
+			status: domain.TaskStatusInProgress,
+			reason: "Missing the summary",
+		},
+		{
+			name:    "hr cannot move tasks",
+			actorID: 30, actorRoleID: domain.RoleHR,
+			task:    domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusSubmitted},
+			status:  domain.TaskStatusDone,
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "start while a blocker is unfinished",
+			actorID: internID, actorRoleID: domain.RoleIntern,
+			task:     domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusTodo},
+			related:  []domain.Task{{ID: 2, InternID: internID, Status: domain.TaskStatusSubmitted}},
+			blockers: []uint{2},
+			status:   domain.TaskStatusInProgress,
+			wantErr:  domain.ErrTaskBlocked,
+		},
+		{
+			name:    "start once blockers are done",
+			actorID: internID, actorRoleID: domain.RoleIntern,
+			task:     domain.Task{ID: 1, InternID: internID, Status: domain.TaskStatusTodo},
+			related:  []domain.Task{{ID: 2, InternID: internID, Status: domain.TaskStatusDone}},
+			blockers: []uint{2},
+			status:   domain.TaskStatusInProgress,
+		},
+		{
+			name:    "submit with an unfinished subtask",
+			actorID: internID, actorRoleID: domain.RoleIntern,
+			task:    domain.Task{ID: parentID, InternID: internID, Status: domain.TaskStatusInProgress},
+			related: []domain.Task{{ID: 6, ParentID: &parentID, InternID: internID, Status: domain.TaskStatusInProgress}},
+			status:  domain.TaskStatusSubmitted,
+			wantErr: domain.ErrSubtasksIncomplete,
+		},
+		{
+			name:    "submit once subtasks are done",
+			actorID: internID, actorRoleID: domain.RoleIntern,
+			task:    domain.Task{ID: parentID, InternID: internID, Status: domain.TaskStatusInProgress},
+			related: []domain.Task{{ID: 6, ParentID: &parentID, InternID: internID, Status: domain.TaskStatusDone}},
+			status:  domain.TaskStatusSubmitted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.task.Deadline = deadline
+			taskRepo := newFakeTaskRepo(append([]domain.Task{tt.task}, tt.related...)...)
+			taskRepo.blockers[tt.task.ID] = tt.blockers
+			internRepo := &fakeInternRepo{profiles: []domain.InternProfile{
+				{UserID: internID, PICID: picID},
+				{UserID: otherInternID, PICID: otherPICID},
+			}}
+			u := &taskUsecase{taskRepo: taskRepo, internRepo: internRepo}
+
+			task, err := u.ChangeStatus(tt.actorID, tt.actorRoleID, tt.task.ID, tt.status, tt.reason)
+			if err != tt.wantErr {
+				t.Fatalf("ChangeStatus() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(taskRepo.history) != 0 {
+					t.Errorf("rejected transition recorded %d history entries", len(taskRepo.history))
+				}
+				return
+			}
+
+			if task.Status != tt.status {
+				t.Errorf("status = %q, want %q", task.Status, tt.status)
+			}
+			if len(taskRepo.history) != 1 {
+				t.Fatalf("recorded %d history entries, want 1", len(taskRepo.history))
+			}
+			entry := taskRepo.history[0]
+			if entry.FromStatus != tt.task.Status || entry.ToStatus != tt.status || entry.ChangedByID != tt.actorID || entry.Reason != tt.reason {
+				t.Errorf("history = %+v, want %s -> %s by %d", entry, tt.task.Status, tt.status, tt.actorID)
+			}
+		})
+	}
+}
+
+func TestLatenessMinutes(t *testing.T) {
+	deadline := time.Date(2026, 3, 10, 23, 59, 59, 0, time.Local)
+	at := func(d time.Duration) *time.Time {
+		t := deadline.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name        string
+		submittedAt *time.Time
+		completedAt *time.Time
+		want        int
+	}{
+		{"not handed in", nil, nil, 0},
+		{"submitted early", at(-time.Hour), at(48 * time.Hour), 0},
+		{"submitted on the deadline", at(0), at(time.Hour), 0},
+		{"submitted late", at(90 * time.Minute), at(48 * time.Hour), 90},
+		{"slow review does not count", at(-time.Minute), at(72 * time.Hour), 0},
+		{"completed without submission", nil, at(30 * time.Minute), 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &domain.Task{Deadline: deadline, SubmittedAt: tt.submittedAt, CompletedAt: tt.completedAt}
+			if got := latenessMinutes(task); got != tt.want {
+				t.Errorf("latenessMinutes() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverdueAt(t *testing.T) {
+	deadline := time.Date(2026, 3, 10, 23, 59, 59, 0, time.Local)
+
+	tests := []struct {
+		name   string
+		status string
+		now    time.Time
+		want   bool
+	}{
+		{"todo before the deadline", domain.TaskStatusTodo, deadline.Add(-time.Hour), false},
+		{"todo at the deadline", domain.TaskStatusTodo, deadline, false},
+		{"todo past the deadline", domain.TaskStatusTodo, deadline.Add(time.Second), true},
+		{"in progress past the deadline", domain.TaskStatusInProgress, deadline.Add(time.Hour), true},
+		{"submitted past the deadline", domain.TaskStatusSubmitted, deadline.Add(time.Hour), false},
+		{"done past the deadline", domain.TaskStatusDone, deadline.Add(time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &domain.Task{Status: tt.status, Deadline: deadline}
+			if got := task.OverdueAt(tt.now); got != tt.want {
+				t.Errorf("OverdueAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	tests := []struct {
+		name    string
+		graph   map[uint][]uint // task to the tasks it waits on
+		from    uint
+		target  uint
+		want    bool
+		wantErr error
+	}{
+		{"no edges", map[uint][]uint{}, 1, 2, false, nil},
+		{"direct", map[uint][]uint{1: {2}}, 1, 2, true, nil},
+		{"reverse direction", map[uint][]uint{2: {1}}, 1, 2, false, nil},
+		{"transitive", map[uint][]uint{1: {3}, 3: {4}, 4: {2}}, 1, 2, true, nil},
+		{"diamond without target", map[uint][]uint{1: {3, 4}, 3: {5}, 4: {5}}, 1, 2, false, nil},
+		{"existing cycle elsewhere terminates", map[uint][]uint{1: {3}, 3: {4}, 4: {3}}, 1, 2, false, nil},
+		{"lookup error", map[uint][]uint{1: {99}}, 1, 2, false, errLookup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waitsOn := func(taskID uint) ([]uint, error) {
+				if taskID == 99 {
+					return nil, errLookup
+				}
+				return tt.graph[taskID], nil
+			}
+
+			got, err := dependsOn(tt.from, tt.target, waitsOn)
+			if err != tt.wantErr {
+				t.Fatalf("dependsOn() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("dependsOn(%d, %d) = %v, want %v", tt.from, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddDependency(t *testing.T) {
+	const (
+		internID      = 10
+		otherInternID = 11
+		picID         = 20
+	)
+	// 1 is the parent of 2, which is the parent of 3; 4 is unrelated, 5 belongs to another intern
+	one, two := uint(1), uint(2)
+	tasks := func() []domain.Task {
+		return []domain.Task{
+			{ID: 1, InternID: internID},
+			{ID: 2, InternID: internID, ParentID: &one},
+			{ID: 3, InternID: internID, ParentID: &two},
+			{ID: 4, InternID: internID},
+			{ID: 5, InternID: otherInternID},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		blockers    map[uint][]uint
+		taskID      uint
+		blockedByID uint
+		wantErr     error
+	}{
+		{"unrelated tasks", nil, 4, 1, nil},
+		{"blocked by itself", nil, 4, 4, domain.ErrInvalidDependency},
+		{"blocked by another intern's task", nil, 4, 5, domain.ErrInvalidDependency},
+		{"direct cycle", map[uint][]uint{4: {1}}, 1, 4, domain.ErrDependencyCycle},
+		{"subtask blocked by its parent", nil, 2, 1, domain.ErrDependencyCycle},
+		{"subtask blocked by its grandparent", nil, 3, 1, domain.ErrDependencyCycle},
+		{"cycle through a blocker of an ancestor", map[uint][]uint{4: {1}}, 3, 4, domain.ErrDependencyCycle},
+		{"parent blocked by its subtask", nil, 1, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := newFakeTaskRepo(tasks()...)
+			for id, blockers := range tt.blockers {
+				taskRepo.blockers[id] = blockers
+			}
+			internRepo := &fakeInternRepo{profiles: []domain.InternProfile{
+				{UserID: internID, PICID: picID},
+				{UserID: otherInternID, PICID: picID},
+			}}
+			u := &taskUsecase{taskRepo: taskRepo, internRepo: internRepo}
+
+			err := u.AddDependency(picID, domain.RolePIC, tt.taskID, tt.blockedByID)
+			if err != tt.wantErr {
+				t.Fatalf("AddDependency(%d, %d) error = %v, want %v", tt.taskID, tt.blockedByID, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"math"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// maxEntryMinutes caps a single time entry at one day
+const maxEntryMinutes = 24 * 60
+
+type timeEntryUsecase struct {
+	entryRepo  domain.TimeEntryRepository
+	taskRepo   domain.TaskRepository
+	internRepo domain.InternRepository
+}
+
+// NewTimeEntryUsecase creates a new time entry usecase
+func NewTimeEntryUsecase(entryRepo domain.TimeEntryRepository, taskRepo domain.TaskRepository, internRepo domain.InternRepository) domain.TimeEntryUsecase {
+	return &timeEntryUsecase{
+		entryRepo:  entryRepo,
+		taskRepo:   taskRepo,
+		internRepo: internRepo,
+	}
+}
+
+// StartTimer starts tracking time on one of the intern's own tasks.
+// An intern can only have one running timer.
+func (u *timeEntryUsecase) StartTimer(actorID, actorRoleID, taskID uint, note string) (*domain.TimeEntry, error) {
+	task, err := u.ownTask(actorID, actorRoleID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.entryRepo.GetRunning(actorID); err == nil {
+		return nil, domain.ErrTimerAlreadyRunning
+	} else if err != domain.ErrNoRunningTimer {
+		return nil, err
+	}
+
+	now := time.Now()
+	entry := &domain.TimeEntry{
+		TaskID:    task.ID,
+		InternID:  actorID,
+		StartedAt: now,
+		Note:      note,
+		Source:    "timer",
+		Status:    domain.TimeEntryPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := u.entryRepo.Create(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// StopTimer stops the intern's running timer and records its duration
+func (u *timeEntryUsecase) StopTimer(actorID, actorRoleID uint) (*domain.TimeEntry, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	entry, err := u.entryRepo.GetRunning(actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	minutes := int(math.Ceil(now.Sub(entry.StartedAt).Minutes()))
+	if minutes > maxEntryMinutes {
+		minutes = maxEntryMinutes
+	}
+
+	entry.EndedAt = &now
+	entry.DurationMinutes = minutes
+	entry.UpdatedAt = now
+
+	if err := u.entryRepo.Update(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// LogTime records a manual time entry on one of the intern's own tasks
+func (u *timeEntryUsecase) LogTime(actorID, actorRoleID, taskID uint, startedAt time.Time, durationMinutes int, note string) (*domain.TimeEntry, error) {
+	if durationMinutes < 1 || durationMinutes > maxEntryMinutes {
+		return nil, domain.ErrInvalidDuration
+	}
+
+	now := time.Now()
+	endedAt := startedAt.Add(time.Duration(durationMinutes) * time.Minute)
+	if endedAt.After(now) {
+		return nil, domain.ErrInvalidDuration
+	}
+
+	task, err := u.ownTask(actorID, actorRoleID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &domain.TimeEntry{
+		TaskID:          task.ID,
+		InternID:        actorID,
+		StartedAt:       startedAt,
+		EndedAt:         &endedAt,
+		DurationMinutes: durationMinutes,
+		Note:            note,
+		Source:          "manual",
+		Status:          domain.TimeEntryPending,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	if err := u.entryRepo.Create(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// GetTaskEntries lists the time entries of a task and their total in minutes
+func (u *timeEntryUsecase) GetTaskEntries(actorID, actorRoleID, taskID uint) ([]domain.TimeEntry, int, error) {
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := authorizeTaskView(u.internRepo, actorID, actorRoleID, task); err != nil {
+		return nil, 0, err
+	}
+
+	entries, err := u.entryRepo.GetByTaskID(taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := 0
+	for _, entry := range entries {
+		total += entry.DurationMinutes
+	}
+
+	return entries, total, nil
+}
+
+// DeleteEntry deletes one of the intern's own entries that has not been reviewed yet
+func (u *timeEntryUsecase) DeleteEntry(actorID, actorRoleID, id uint) error {
+	entry, err := u.entryRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if actorRoleID != domain.RoleIntern || entry.InternID != actorID {
+		return domain.ErrForbidden
+	}
+	if entry.Status != domain.TimeEntryPending {
+		return domain.ErrTimeEntryNotEditable
+	}
+
+	return u.entryRepo.Delete(id)
+}
+
+// ReviewEntry approves or rejects a finished, pending entry. Only the intern's PIC or HR may review,
+// and a reviewed entry keeps its outcome.
+func (u *timeEntryUsecase) ReviewEntry(actorID, actorRoleID, id uint, approve bool) (*domain.TimeEntry, error) {
+	entry, err := u.entryRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, entry.InternID); err != nil {
+		return nil, err
+	}
+	if entry.EndedAt == nil || entry.Status != domain.TimeEntryPending {
+		return nil, domain.ErrTimeEntryNotEditable
+	}
+
+	now := time.Now()
+	entry.Status = domain.TimeEntryRejected
+	if approve {
+		entry.Status = domain.TimeEntryApproved
+	}
+	entry.ReviewedByID = &actorID
+	entry.ReviewedAt = &now
+	entry.UpdatedAt = now
+
+	if err := u.entryRepo.Update(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// GetTimesheet summarises an intern's time over [from, to) with daily and per-task totals
+func (u *timeEntryUsecase) GetTimesheet(actorID, actorRoleID, internID uint, from, to time.Time) (*domain.Timesheet, error) {
+	if actorRoleID == domain.RoleIntern {
+		if internID != actorID {
+			return nil, domain.ErrForbidden
+		}
+	} else if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return nil, err
+	}
+
+	entries, err := u.entryRepo.GetByInternAndPeriod(internID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := &domain.Timesheet{
+		InternID:    internID,
+		From:        from,
+		To:          to,
+		Entries:     entries,
+		DailyTotals: make(map[string]int),
+		TaskTotals:  []domain.TaskTimeTotal{},
+	}
+
+	taskIndex := make(map[uint]int)
+	for _, entry := range entries {
+		sheet.TotalMinutes += entry.DurationMinutes
+		if entry.Status == domain.TimeEntryApproved {
+			sheet.ApprovedMinutes += entry.DurationMinutes
+		}
+		sheet.DailyTotals[entry.StartedAt.In(time.Local).Format("2006-01-02")] += entry.DurationMinutes
+
+		i, ok := taskIndex[entry.TaskID]
+		if !ok {
+			title := ""
+			if entry.Task != nil {
+				title = entry.Task.Title
+			}
+			sheet.TaskTotals = append(sheet.TaskTotals, domain.TaskTimeTotal{TaskID: entry.TaskID, Title: title})
+			i = len(sheet.TaskTotals) - 1
+			taskIndex[entry.TaskID] = i
+		}
+		sheet.TaskTotals[i].Minutes += entry.DurationMinutes
+	}
+
+	return sheet, nil
+}
+
+// ApproveTimesheet approves every pending, finished entry of an intern within [from, to)
+func (u *timeEntryUsecase) ApproveTimesheet(actorID, actorRoleID, internID uint, from, to time.Time) (int64, error) {
+	if err := authorizeInternManagement(u.internRepo, actorID, actorRoleID, internID); err != nil {
+		return 0, err
+	}
+
+	return u.entryRepo.ApprovePeriod(internID, from, to, actorID, time.Now())
+}
+
+// ownTask loads a task of the acting intern that can still receive time
+func (u *timeEntryUsecase) ownTask(actorID, actorRoleID, taskID uint) (*domain.Task, error) {
+	if actorRoleID != domain.RoleIntern {
+		return nil, domain.ErrForbidden
+	}
+
+	task, err := u.taskRepo.GetByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.InternID != actorID {
+		return nil, domain.ErrForbidden
+	}
+	if task.Status == domain.TaskStatusDone {
+		return nil, domain.ErrTimeEntryNotEditable
+	}
+
+	return task, nil
+}
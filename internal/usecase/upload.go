@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+	"backend-dashboard/pkg/storage"
+)
+
+// allowedUploadTypes lists accepted MIME types as detected from file content.
+// Office documents (docx, xlsx, pptx) are detected as application/zip, and CSV
+// files as text/plain.
+var allowedUploadTypes = map[string]bool{
+	"application/pdf": true,
+	"application/zip": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"text/plain":      true,
+}
+
+// storedFile describes an upload written by storeUpload
+type storedFile struct {
+	ContentType string
+	Size        int64
+	Checksum    string
+}
+
+// storeUpload checks the content type of an upload and writes it to key, rejecting
+// files larger than maxSize. Nothing is left in storage when it fails.
+func storeUpload(store storage.Storage, key string, content io.Reader, maxSize int64) (*storedFile, error) {
+	// Sniff the content type from the first bytes instead of trusting the client
+	reader := bufio.NewReaderSize(content, 512)
+	head, _ := reader.Peek(512)
+	contentType := http.DetectContentType(head)
+	if !allowedUploadTypes[strings.TrimSpace(strings.Split(contentType, ";")[0])] {
+		return nil, domain.ErrFileTypeNotAllowed
+	}
+
+	// Hash while writing and read one byte past the limit to catch oversized bodies
+	hasher := sha256.New()
+	counter := &countingReader{r: io.LimitReader(reader, maxSize+1)}
+	if err := store.Save(key, io.TeeReader(counter, hasher)); err != nil {
+		return nil, err
+	}
+	if counter.n > maxSize {
+		store.Delete(key)
+		return nil, domain.ErrFileTooLarge
+	}
+
+	return &storedFile{
+		ContentType: contentType,
+		Size:        counter.n,
+		Checksum:    hex.EncodeToString(hasher.Sum(nil)),
+	}, nil
+}
+
+// uploadKey builds a unique storage key under prefix that never contains user input other than the extension
+func uploadKey(prefix, fileName string) (string, error) {
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if len(ext) > 10 || strings.ContainsAny(ext, `/\`) {
+		ext = ""
+	}
+
+	return fmt.Sprintf("%s/%d-%s%s", prefix, time.Now().UnixNano(), hex.EncodeToString(random), ext), nil
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
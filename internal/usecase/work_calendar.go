@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+// workCalendar answers whether interns are expected at work on a day, combining
+// work schedules with the holiday calendar over a preloaded date range
+type workCalendar struct {
+	scheduleRepo domain.WorkScheduleRepository
+	schedules    map[string]*domain.WorkSchedule
+	holidays     map[string]string // date to holiday name
+}
+
+// loadWorkCalendar loads the holidays within [from, to]. Days outside the range are
+// treated as if they had no holiday.
+func loadWorkCalendar(scheduleRepo domain.WorkScheduleRepository, holidayRepo domain.HolidayRepository, from, to time.Time) (*workCalendar, error) {
+	holidays, err := holidayRepo.GetBetween(startOfDay(from), startOfDay(to))
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &workCalendar{
+		scheduleRepo: scheduleRepo,
+		schedules:    make(map[string]*domain.WorkSchedule),
+		holidays:     make(map[string]string, len(holidays)),
+	}
+	for _, holiday := range holidays {
+		calendar.holidays[holiday.Date.Format("2006-01-02")] = holiday.Name
+	}
+
+	return calendar, nil
+}
+
+// schedule resolves the work schedule of a cohort, caching lookups.
+// A nil schedule means none is configured.
+func (c *workCalendar) schedule(division, batch string) (*domain.WorkSchedule, error) {
+	key := division + "\x00" + batch
+	if schedule, ok := c.schedules[key]; ok {
+		return schedule, nil
+	}
+
+	schedule, err := c.scheduleRepo.FindFor(division, batch)
+	if err != nil && err != domain.ErrWorkScheduleNotFound {
+		return nil, err
+	}
+	c.schedules[key] = schedule
+	return schedule, nil
+}
+
+// isHoliday reports whether the day is on the holiday calendar
+func (c *workCalendar) isHoliday(day time.Time) bool {
+	_, ok := c.holidays[day.Format("2006-01-02")]
+	return ok
+}
+
+// holidayName returns the name of the holiday on the day, or "" when there is none
+func (c *workCalendar) holidayName(day time.Time) string {
+	return c.holidays[day.Format("2006-01-02")]
+}
+
+// isWorkingDay reports whether the schedule has office hours on the day and it is not a holiday
+func (c *workCalendar) isWorkingDay(schedule *domain.WorkSchedule, day time.Time) bool {
+	return isWorkingDay(schedule, day) && !c.isHoliday(day)
+}
+
+// workingDays lists the working days of a cohort within [from, to]
+func (c *workCalendar) workingDays(division, batch string, from, to time.Time) ([]time.Time, error) {
+	schedule, err := c.schedule(division, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []time.Time
+	for day, last := startOfDay(from), startOfDay(to); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if c.isWorkingDay(schedule, day) {
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+// isWorkingDay falls back to Monday to Friday when no schedule is configured
+func isWorkingDay(schedule *domain.WorkSchedule, day time.Time) bool {
+	if schedule != nil {
+		return schedule.IsWorkingDay(day)
+	}
+	return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+}
+
+// workdayEnd is when an intern can no longer check in for the day
+func workdayEnd(schedule *domain.WorkSchedule, day time.Time) time.Time {
+	if schedule != nil {
+		return schedule.EndOn(day)
+	}
+	return endOfDay(day)
+}
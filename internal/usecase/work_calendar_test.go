@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// formatDays renders days as YYYY-MM-DD for readable comparisons
+func formatDays(days []time.Time) []string {
+	formatted := make([]string, 0, len(days))
+	for _, day := range days {
+		formatted = append(formatted, day.Format("2006-01-02"))
+	}
+	return formatted
+}
+
+func TestWorkCalendarWorkingDays(t *testing.T) {
+	// 2026-03-02 is a Monday; Wednesday 2026-03-04 is a holiday
+	holidays := []domain.Holiday{{Date: date(2026, 3, 4), Name: "Hari Raya Nyepi"}}
+	schedules := []domain.WorkSchedule{
+		{Division: "Engineering", WorkingDays: "1,2,3,4,5,6", StartTime: "09:00", EndTime: "17:00"},
+		{Division: "Design", Batch: "2026A", WorkingDays: "1,3,5", StartTime: "09:00", EndTime: "17:00"},
+	}
+
+	tests := []struct {
+		name     string
+		division string
+		batch    string
+		from     time.Time
+		to       time.Time
+		want     []string
+	}{
+		{
+			name:     "no schedule falls back to monday to friday",
+			division: "Finance",
+			from:     date(2026, 3, 2), to: date(2026, 3, 8),
+			want: []string{"2026-03-02", "2026-03-03", "2026-03-05", "2026-03-06"},
+		},
+		{
+			name:     "division schedule with saturdays",
+			division: "Engineering", batch: "2026A",
+			from: date(2026, 3, 2), to: date(2026, 3, 8),
+			want: []string{"2026-03-02", "2026-03-03", "2026-03-05", "2026-03-06", "2026-03-07"},
+		},
+		{
+			name:     "cohort schedule minus the holiday",
+			division: "Design", batch: "2026A",
+			from: date(2026, 3, 2), to: date(2026, 3, 8),
+			want: []string{"2026-03-02", "2026-03-06"},
+		},
+		{
+			name:     "another batch of the division uses the default",
+			division: "Design", batch: "2026B",
+			from: date(2026, 3, 2), to: date(2026, 3, 4),
+			want: []string{"2026-03-02", "2026-03-03"},
+		},
+		{
+			name:     "times within the day are ignored",
+			division: "Finance",
+			from:     date(2026, 3, 3).Add(15 * time.Hour), to: date(2026, 3, 5).Add(8 * time.Hour),
+			want: []string{"2026-03-03", "2026-03-05"},
+		},
+		{
+			name:     "weekend only",
+			division: "Finance",
+			from:     date(2026, 3, 7), to: date(2026, 3, 8),
+			want: []string{},
+		},
+		{
+			name:     "empty range",
+			division: "Finance",
+			from:     date(2026, 3, 6), to: date(2026, 3, 5),
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar, err := loadWorkCalendar(&fakeScheduleRepo{schedules: schedules}, &fakeHolidayRepo{holidays: holidays}, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("loadWorkCalendar() error = %v", err)
+			}
+
+			days, err := calendar.workingDays(tt.division, tt.batch, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("workingDays() error = %v", err)
+			}
+			if got := formatDays(days); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("workingDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkAlphaRange(t *testing.T) {
+	// 2026-03-02 is a Monday; Wednesday 2026-03-04 is a holiday
+	holidays := []domain.Holiday{{Date: date(2026, 3, 4), Name: "Hari Raya Nyepi"}}
+	schedules := []domain.WorkSchedule{{WorkingDays: "1,2,3,4,5", StartTime: "09:00", EndTime: "17:00"}}
+	profiles := []domain.InternProfile{
+		{UserID: 10, StartDate: date(2026, 1, 5), EndDate: date(2026, 6, 30)},
+		{UserID: 11, StartDate: date(2026, 3, 3), EndDate: date(2026, 6, 30)}, // starts mid-range
+		{UserID: 12, StartDate: date(2025, 9, 1), EndDate: date(2026, 3, 2)},  // ends on the first day
+	}
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		now  time.Time
+		want map[uint][]string
+	}{
+		{
+			name: "skips holidays and days outside the internship",
+			from: date(2026, 3, 2), to: date(2026, 3, 6),
+			now: date(2026, 3, 9).Add(8 * time.Hour),
+			want: map[uint][]string{
+				10: {"2026-03-02", "2026-03-03", "2026-03-05", "2026-03-06"},
+				11: {"2026-03-03", "2026-03-05", "2026-03-06"},
+				12: {"2026-03-02"},
+			},
+		},
+		{
+			name: "today only after office hours",
+			from: date(2026, 3, 5), to: date(2026, 3, 6),
+			now: date(2026, 3, 6).Add(16 * time.Hour),
+			want: map[uint][]string{
+				10: {"2026-03-05"},
+				11: {"2026-03-05"},
+			},
+		},
+		{
+			name: "today once office hours ended",
+			from: date(2026, 3, 5), to: date(2026, 3, 6),
+			now: date(2026, 3, 6).Add(17 * time.Hour),
+			want: map[uint][]string{
+				10: {"2026-03-05", "2026-03-06"},
+				11: {"2026-03-05", "2026-03-06"},
+			},
+		},
+		{
+			name: "weekend",
+			from: date(2026, 3, 7), to: date(2026, 3, 8),
+			now:  date(2026, 3, 9),
+			want: map[uint][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attendanceRepo := &fakeAttendanceRepo{}
+			u := &attendanceUsecase{
+				attendanceRepo: attendanceRepo,
+				scheduleRepo:   &fakeScheduleRepo{schedules: schedules},
+				holidayRepo:    &fakeHolidayRepo{holidays: holidays},
+				internRepo:     &fakeInternRepo{profiles: profiles},
+			}
+
+			created, err := u.MarkAlphaRange(tt.from, tt.to, tt.now)
+			if err != nil {
+				t.Fatalf("MarkAlphaRange() error = %v", err)
+			}
+
+			got := make(map[uint][]string)
+			for _, record := range attendanceRepo.created {
+				if record.Status != domain.AttendanceAlpha {
+					t.Errorf("record of %d on %s has status %q", record.InternID, record.Date.Format("2006-01-02"), record.Status)
+				}
+				got[record.InternID] = append(got[record.InternID], record.Date.Format("2006-01-02"))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("marked alpha = %v, want %v", got, tt.want)
+			}
+			if created != int64(len(attendanceRepo.created)) {
+				t.Errorf("created = %d, want %d", created, len(attendanceRepo.created))
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-dashboard/internal/domain"
+)
+
+type workScheduleUsecase struct {
+	scheduleRepo domain.WorkScheduleRepository
+	internRepo   domain.InternRepository
+}
+
+// NewWorkScheduleUsecase creates a new work schedule usecase
+func NewWorkScheduleUsecase(scheduleRepo domain.WorkScheduleRepository, internRepo domain.InternRepository) domain.WorkScheduleUsecase {
+	return &workScheduleUsecase{
+		scheduleRepo: scheduleRepo,
+		internRepo:   internRepo,
+	}
+}
+
+// CreateSchedule creates a schedule for a division and/or batch. Leave both empty for the default schedule.
+func (u *workScheduleUsecase) CreateSchedule(division, batch, startTime, endTime string, gracePeriodMinutes int, workingDays []int) (*domain.WorkSchedule, error) {
+	days, err := validateSchedule(startTime, endTime, gracePeriodMinutes, workingDays)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.scheduleRepo.GetByCohort(division, batch); err == nil {
+		return nil, domain.ErrWorkScheduleExists
+	} else if err != domain.ErrWorkScheduleNotFound {
+		return nil, err
+	}
+
+	now := time.Now()
+	schedule := &domain.WorkSchedule{
+		Division:           division,
+		Batch:              batch,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		GracePeriodMinutes: gracePeriodMinutes,
+		WorkingDays:        days,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if err := u.scheduleRepo.Create(schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// GetSchedules gets all work schedules
+func (u *workScheduleUsecase) GetSchedules() ([]domain.WorkSchedule, error) {
+	return u.scheduleRepo.GetAll()
+}
+
+// GetScheduleByID gets a work schedule by ID
+func (u *workScheduleUsecase) GetScheduleByID(id uint) (*domain.WorkSchedule, error) {
+	return u.scheduleRepo.GetByID(id)
+}
+
+// GetScheduleForIntern gets the schedule that applies to an intern
+func (u *workScheduleUsecase) GetScheduleForIntern(userID uint) (*domain.WorkSchedule, error) {
+	profile, err := u.internRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.scheduleRepo.FindFor(profile.Division, profile.Batch)
+}
+
+// UpdateSchedule updates a work schedule
+func (u *workScheduleUsecase) UpdateSchedule(id uint, division, batch, startTime, endTime string, gracePeriodMinutes int, workingDays []int) (*domain.WorkSchedule, error) {
+	days, err := validateSchedule(startTime, endTime, gracePeriodMinutes, workingDays)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := u.scheduleRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, err := u.scheduleRepo.GetByCohort(division, batch); err == nil && existing.ID != id {
+		return nil, domain.ErrWorkScheduleExists
+	} else if err != nil && err != domain.ErrWorkScheduleNotFound {
+		return nil, err
+	}
+
+	schedule.Division = division
+	schedule.Batch = batch
+	schedule.StartTime = startTime
+	schedule.EndTime = endTime
+	schedule.GracePeriodMinutes = gracePeriodMinutes
+	schedule.WorkingDays = days
+	schedule.UpdatedAt = time.Now()
+
+	if err := u.scheduleRepo.Update(schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// DeleteSchedule deletes a work schedule
+func (u *workScheduleUsecase) DeleteSchedule(id uint) error {
+	if _, err := u.scheduleRepo.GetByID(id); err != nil {
+		return err
+	}
+
+	return u.scheduleRepo.Delete(id)
+}
+
+// validateSchedule checks the office hours and returns the working days in their stored form
+func validateSchedule(startTime, endTime string, gracePeriodMinutes int, workingDays []int) (string, error) {
+	start, err := time.Parse("15:04", startTime)
+	if err != nil {
+		return "", domain.ErrInvalidWorkSchedule
+	}
+	end, err := time.Parse("15:04", endTime)
+	if err != nil || !end.After(start) {
+		return "", domain.ErrInvalidWorkSchedule
+	}
+	if gracePeriodMinutes < 0 || len(workingDays) == 0 {
+		return "", domain.ErrInvalidWorkSchedule
+	}
+
+	seen := make(map[int]bool)
+	var days []int
+	for _, day := range workingDays {
+		if day < 0 || day > 6 {
+			return "", domain.ErrInvalidWorkSchedule
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	sort.Ints(days)
+
+	parts := make([]string, len(days))
+	for i, day := range days {
+		parts[i] = strconv.Itoa(day)
+	}
+	return strings.Join(parts, ","), nil
+}
@@ -29,6 +29,10 @@ func Connect(cfg *config.Config) (*gorm.DB, error) {
 }
 
 func AutoMigrate(db *gorm.DB) {
+	dedupeMentorReviews(db)
+	backfillMentorReviewStatus(db)
+	dedupePotentialScores(db)
+
 	// Migrate all models in correct order (dependencies first)
 	err := db.AutoMigrate(
 		&domain.Role{},
@@ -36,9 +40,35 @@ func AutoMigrate(db *gorm.DB) {
 		&domain.InternProfile{},
 		&domain.PICProfile{},
 		&domain.HRProfile{},
+		&domain.TaskTemplate{},
+		&domain.TaskRecurrence{},
+		&domain.SkippedOccurrence{},
 		&domain.Task{},
+		&domain.TaskDependency{},
+		&domain.TaskStatusHistory{},
+		&domain.TaskGrade{},
+		&domain.TaskComment{},
+		&domain.TaskCommentMention{},
+		&domain.TaskAttachment{},
+		&domain.TimeEntry{},
+		&domain.WorkSchedule{},
+		&domain.Holiday{},
+		&domain.OfficeLocation{},
 		&domain.Attendance{},
+		&domain.LeaveRequest{},
+		&domain.AttendanceCorrection{},
+		&domain.ReviewRubric{},
+		&domain.RubricCriterion{},
+		&domain.ReviewCycle{},
+		&domain.ReviewReopening{},
 		&domain.MentorReview{},
+		&domain.ReviewAnswer{},
+		&domain.SelfAssessment{},
+		&domain.SelfAssessmentAnswer{},
+		&domain.PeerFeedbackRound{},
+		&domain.PeerFeedbackAssignment{},
+		&domain.PeerFeedback{},
+		&domain.PeerFeedbackAnswer{},
 		&domain.PerformanceScore{},
 		&domain.PotentialScore{},
 		&domain.NineGridResult{},
@@ -50,6 +80,56 @@ func AutoMigrate(db *gorm.DB) {
 	log.Println("Database migration completed successfully")
 }
 
+// dedupeMentorReviews keeps only the newest mentor review per intern and period,
+// which the unique index on (intern_id, period) requires of databases created before it
+func dedupeMentorReviews(db *gorm.DB) {
+	if !db.Migrator().HasTable(&domain.MentorReview{}) {
+		return
+	}
+	err := db.Exec(`DELETE FROM mentor_reviews older USING mentor_reviews newer
+		WHERE older.intern_id = newer.intern_id AND older.period = newer.period AND older.id < newer.id`).Error
+	if err != nil {
+		log.Fatalf("Failed to dedupe mentor reviews: %v", err)
+	}
+}
+
+// backfillMentorReviewStatus marks reviews written before review statuses existed as submitted.
+// The status column defaults to draft, which would otherwise hide them from interns and let
+// PICs rewrite them.
+func backfillMentorReviewStatus(db *gorm.DB) {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&domain.MentorReview{}) || migrator.HasColumn(&domain.MentorReview{}, "Status") {
+		return
+	}
+
+	submittedAt := "created_at"
+	if migrator.HasColumn(&domain.MentorReview{}, "finalized_at") {
+		submittedAt = "COALESCE(finalized_at, created_at)"
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`ALTER TABLE mentor_reviews ADD COLUMN status text, ADD COLUMN IF NOT EXISTS submitted_at timestamptz`).Error; err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE mentor_reviews SET status = ?, submitted_at = "+submittedAt, domain.ReviewSubmitted).Error
+	})
+	if err != nil {
+		log.Fatalf("Failed to backfill mentor review status: %v", err)
+	}
+}
+
+// dedupePotentialScores keeps only the newest potential score per intern and period,
+// which the unique index on (intern_id, period) requires of databases created before it
+func dedupePotentialScores(db *gorm.DB) {
+	if !db.Migrator().HasTable(&domain.PotentialScore{}) {
+		return
+	}
+	err := db.Exec(`DELETE FROM potential_scores older USING potential_scores newer
+		WHERE older.intern_id = newer.intern_id AND older.period = newer.period AND older.id < newer.id`).Error
+	if err != nil {
+		log.Fatalf("Failed to dedupe potential scores: %v", err)
+	}
+}
+
 func SeedRoles(db *gorm.DB) {
 	roles := []domain.Role{
 		{Name: "super_admin", Description: "Super Administrator with full system access"},
@@ -112,6 +192,35 @@ func SeedSuperAdmin(db *gorm.DB) {
 	}
 }
 
+// SeedDefaultRubric creates the shared rubric with the four classic mentor review criteria,
+// so reviews can be written before HR defines division rubrics
+func SeedDefaultRubric(db *gorm.DB) {
+	var count int64
+	db.Model(&domain.ReviewRubric{}).Count(&count)
+	if count > 0 {
+		return
+	}
+
+	rubric := domain.ReviewRubric{
+		Name:    "Default Mentor Review",
+		Version: 1,
+		Scale:   5,
+		Criteria: []domain.RubricCriterion{
+			{Name: "Learning Ability", Description: "Picks up new tools, domains and feedback quickly", Weight: 1, Position: 1},
+			{Name: "Initiative", Description: "Acts without being asked and proposes improvements", Weight: 1, Position: 2},
+			{Name: "Communication", Description: "Reports progress and blockers clearly and on time", Weight: 1, Position: 3},
+			{Name: "Problem Solving", Description: "Breaks problems down and finds workable solutions", Weight: 1, Position: 4},
+		},
+		CreatedAt: time.Now(),
+	}
+
+	if err := db.Create(&rubric).Error; err != nil {
+		log.Printf("Failed to seed default review rubric: %v", err)
+	} else {
+		log.Println("Default review rubric seeded successfully")
+	}
+}
+
 func SeedSampleData(db *gorm.DB) {
 	// Check if sample data already exists
 	var userCount int64
@@ -0,0 +1,143 @@
+package ical
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // TZID lookups must not depend on the host's zoneinfo
+)
+
+func TestParse(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []Event
+		wantErr bool
+	}{
+		{
+			name: "all-day event",
+			input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:nyepi@example.com\r\nSUMMARY:Hari Raya Nyepi\r\n" +
+				"DTSTART;VALUE=DATE:20260319\r\nDTEND;VALUE=DATE:20260320\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			want: []Event{{
+				UID:     "nyepi@example.com",
+				Summary: "Hari Raya Nyepi",
+				Start:   time.Date(2026, 3, 19, 0, 0, 0, 0, jakarta),
+				End:     time.Date(2026, 3, 20, 0, 0, 0, 0, jakarta),
+				AllDay:  true,
+			}},
+		},
+		{
+			name:  "folded lines and escapes",
+			input: "BEGIN:VEVENT\nSUMMARY:Cuti Bersama\\, Idul\n  Fitri\\nhari kedua\nDTSTART:20260323\nEND:VEVENT\n",
+			want: []Event{{
+				Summary: "Cuti Bersama, Idul Fitri hari kedua",
+				Start:   time.Date(2026, 3, 23, 0, 0, 0, 0, jakarta),
+				AllDay:  true,
+			}},
+		},
+		{
+			name:  "timed event in UTC",
+			input: "BEGIN:VEVENT\nSUMMARY:Town hall\nDTSTART:20260301T020000Z\nDTEND:20260301T040000Z\nEND:VEVENT\n",
+			want: []Event{{
+				Summary: "Town hall",
+				Start:   time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC),
+				End:     time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name:  "timed event with TZID",
+			input: "BEGIN:VEVENT\nSUMMARY:Partner visit\nDTSTART;TZID=Asia/Tokyo:20260301T090000\nEND:VEVENT\n",
+			want: []Event{{
+				Summary: "Partner visit",
+				Start:   time.Date(2026, 3, 1, 9, 0, 0, 0, tokyo),
+			}},
+		},
+		{
+			name:  "properties outside events are ignored",
+			input: "BEGIN:VCALENDAR\nX-WR-CALNAME:Libur Nasional\nSUMMARY:Not an event\nEND:VCALENDAR\n",
+			want:  nil,
+		},
+		{
+			name:    "event without start",
+			input:   "BEGIN:VEVENT\nSUMMARY:Broken\nEND:VEVENT\n",
+			wantErr: true,
+		},
+		{
+			name:    "end without begin",
+			input:   "SUMMARY:Broken\nEND:VEVENT\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid date",
+			input:   "BEGIN:VEVENT\nDTSTART:2026-03-01\nEND:VEVENT\n",
+			wantErr: true,
+		},
+		{
+			name:    "event spanning more than a month",
+			input:   "BEGIN:VEVENT\nSUMMARY:Ramadan\nDTSTART;VALUE=DATE:20260218\nDTEND;VALUE=DATE:20260401\nEND:VEVENT\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Parse(strings.NewReader(tt.input), jakarta)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(events) != len(tt.want) {
+				t.Fatalf("Parse() returned %d events, want %d", len(events), len(tt.want))
+			}
+			for i, got := range events {
+				want := tt.want[i]
+				if got.UID != want.UID || got.Summary != want.Summary || got.AllDay != want.AllDay ||
+					!got.Start.Equal(want.Start) || !got.End.Equal(want.End) {
+					t.Errorf("event %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEventDays(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, jakarta) }
+	at := func(d, hour int, loc *time.Location) time.Time { return time.Date(2026, 3, d, hour, 0, 0, 0, loc) }
+
+	tests := []struct {
+		name  string
+		event Event
+		want  []string
+	}{
+		{"all-day without end", Event{Start: day(19), AllDay: true}, []string{"2026-03-19"}},
+		{"all-day end is exclusive", Event{Start: day(19), End: day(20), AllDay: true}, []string{"2026-03-19"}},
+		{"multi-day", Event{Start: day(20), End: day(24), AllDay: true}, []string{"2026-03-20", "2026-03-21", "2026-03-22", "2026-03-23"}},
+		{"all-day end before start", Event{Start: day(19), End: day(18), AllDay: true}, []string{"2026-03-19"}},
+		{"timed within a day", Event{Start: at(1, 9, jakarta), End: at(1, 12, jakarta)}, []string{"2026-03-01"}},
+		{"timed into the next day", Event{Start: at(1, 20, jakarta), End: at(2, 10, jakarta)}, []string{"2026-03-01", "2026-03-02"}},
+		{"timed ending at midnight", Event{Start: at(1, 20, jakarta), End: at(2, 0, jakarta)}, []string{"2026-03-01"}},
+		{"UTC shifted into the local day", Event{Start: at(1, 20, time.UTC), End: at(1, 22, time.UTC)}, []string{"2026-03-02"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, d := range tt.event.Days(jakarta) {
+				got = append(got, d.Format("2006-01-02"))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Days() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
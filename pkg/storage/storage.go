@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"backend-dashboard/internal/config"
+	"fmt"
+	"io"
+)
+
+// Storage abstracts where uploaded files are kept so the local disk
+// can be swapped for an S3-compatible object store
+type Storage interface {
+	Save(key string, r io.Reader) error
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+// New creates the storage backend selected by STORAGE_DRIVER
+func New(cfg *config.Config) (Storage, error) {
+	switch cfg.StorageDriver {
+	case "local", "":
+		return NewLocalStorage(cfg.StorageDir)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", cfg.StorageDriver)
+	}
+}